@@ -53,8 +53,21 @@ func main() {
                    tenant admin has in each managed cluster.
     register       Onboard an existing non CAPI cluster by creating all necessary internal resources.
     deregister     Remove a non CAPI cluster that was previously registered with Sveltos.
+    rotate         Rotates the token of the kubeconfig stored for registered clusters.
+    check          Verifies registered clusters are reachable and grant Sveltos the permissions it needs.
     redeploy.      Forces Sveltos to re-apply all configured add-ons and resources for a specified cluster,
                    bypassing the internal reconciliation status check.
+    pause          Pauses a ClusterProfile/Profile, freezing its rollout in all matching clusters.
+    resume         Resumes a previously paused ClusterProfile/Profile.
+    cordon         Stops Sveltos from deploying add-on changes to a cluster.
+    uncordon       Resumes deploying add-on changes to a previously cordoned cluster.
+    wait           Waits for a ClusterProfile/Profile add-ons to be deployed in all matching clusters.
+    render         Renders a ClusterProfile/Profile stored in a file against a cluster and prints the manifests.
+    snapshot       Displays collected snapshots. Visualize diffs between two collected snapshots.
+                   Rollback to any collected snapshot.
+    agent          Runs sveltosctl in long-running mode, collecting snapshots on schedule.
+    techsupport    Collects logs and resources from management and managed clusters in a
+                   single bundle to attach to bug reports.
     generate       Generates a Kubeconfig that can later be used to register a cluster.
                    Run this command with sveltosctl pointing to the cluster you want Sveltos to manage.
     log-level      Allows changing the log verbosity.
@@ -119,6 +132,10 @@ Description:
 			err = commands.RegisterCluster(ctx, args, logger)
 		case "deregister":
 			err = commands.DeregisterCluster(ctx, args, logger)
+		case "rotate":
+			err = commands.Rotate(ctx, args, logger)
+		case "check":
+			err = commands.Check(ctx, args, logger)
 		case "generate":
 			err = commands.Generate(ctx, args, logger)
 		case "log-level":
@@ -127,12 +144,31 @@ Description:
 			err = commands.Version(args, logger)
 		case "redeploy":
 			err = commands.RedeployCluster(ctx, args, logger)
+		case "pause":
+			err = commands.Pause(ctx, args, logger)
+		case "resume":
+			err = commands.Resume(ctx, args, logger)
+		case "cordon":
+			err = commands.Cordon(ctx, args, logger)
+		case "uncordon":
+			err = commands.Uncordon(ctx, args, logger)
+		case "wait":
+			err = commands.Wait(ctx, args, logger)
+		case "render":
+			err = commands.Render(ctx, args, logger)
+		case "snapshot":
+			err = commands.Snapshot(ctx, args, logger)
+		case "agent":
+			err = commands.Agent(ctx, args, logger)
+		case "techsupport":
+			err = commands.TechSupport(ctx, args, logger)
 		default:
 			err = fmt.Errorf("unknown command: %q\n%s", command, doc)
 		}
 
 		if err != nil {
 			logger.V(logs.LogInfo).Info(fmt.Sprintf("%v\n", err))
+			os.Exit(1)
 		}
 	}
 }
@@ -158,7 +194,7 @@ func initializeManagementClusterAccess() (*clusterAccess, error) {
 		return nil, werr
 	}
 
-	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	c, err := client.NewWithWatch(restConfig, client.Options{Scheme: scheme})
 	if err != nil {
 		werr := fmt.Errorf("failed to connect: %w", err)
 		return nil, werr
@@ -0,0 +1,268 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	// permission used for directories and files created by the collector
+	dirPermission  = 0o755
+	filePermission = 0o600
+
+	yamlExtension = ".yaml"
+)
+
+// reference identifies a ConfigMap/Secret referenced by a Sveltos resource
+type reference struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// DumpObject stores obj, in YAML format, in folder/<Kind>/<namespace>/<name>.yaml.
+// Cluster wide resources are stored in folder/<Kind>/<name>.yaml.
+// ManagedFields are removed as those are not relevant to reconstruct the object.
+func DumpObject(obj client.Object, folder string, logger logr.Logger) error {
+	gvk, err := apiutil.GVKForObject(obj, utils.GetAccessInstance().GetScheme())
+	if err != nil {
+		return err
+	}
+
+	obj = obj.DeepCopyObject().(client.Object)
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetManagedFields(nil)
+
+	objectFolder := filepath.Join(folder, gvk.Kind, obj.GetNamespace())
+	if err := os.MkdirAll(objectFolder, dirPermission); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	fileName := filepath.Join(objectFolder, obj.GetName()+yamlExtension)
+	logger.V(logs.LogDebug).Info(fmt.Sprintf("storing %s %s/%s in %s",
+		gvk.Kind, obj.GetNamespace(), obj.GetName(), fileName))
+	return os.WriteFile(fileName, data, filePermission)
+}
+
+// LoadObjects returns all the objects previously stored with DumpObject in folder
+func LoadObjects(folder string) ([]*unstructured.Unstructured, error) {
+	objects := make([]*unstructured.Unstructured, 0)
+	err := filepath.WalkDir(folder, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != yamlExtension {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		u := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &u.Object); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		objects = append(objects, u)
+		return nil
+	})
+
+	return objects, err
+}
+
+// CollectSveltosConfiguration stores in folder the Sveltos configuration: ClusterProfiles,
+// Profiles, Classifiers, RoleRequests, EventTriggers and the ConfigMaps/Secrets those reference.
+func CollectSveltosConfiguration(ctx context.Context, folder string, logger logr.Logger) error {
+	instance := utils.GetAccessInstance()
+
+	references := make(map[reference]bool)
+
+	clusterProfiles, err := instance.ListClusterProfiles(ctx, logger)
+	if err != nil {
+		return err
+	}
+	for i := range clusterProfiles.Items {
+		cp := &clusterProfiles.Items[i]
+		if err := DumpObject(cp, folder, logger); err != nil {
+			return err
+		}
+		// Empty namespace in a ClusterProfile means the namespace of the matching cluster
+		namespaces := getMatchingClusterNamespaces(cp.Status.MatchingClusterRefs)
+		addProfileReferences(references, &cp.Spec, namespaces)
+	}
+
+	profiles, err := instance.ListProfiles(ctx, logger)
+	if err != nil {
+		return err
+	}
+	for i := range profiles.Items {
+		p := &profiles.Items[i]
+		if err := DumpObject(p, folder, logger); err != nil {
+			return err
+		}
+		addProfileReferences(references, &p.Spec, []string{p.Namespace})
+	}
+
+	classifiers, err := instance.ListClassifiers(ctx, logger)
+	if err != nil {
+		return err
+	}
+	for i := range classifiers.Items {
+		if err := DumpObject(&classifiers.Items[i], folder, logger); err != nil {
+			return err
+		}
+	}
+
+	roleRequests, err := instance.ListRoleRequests(ctx, logger)
+	if err != nil {
+		return err
+	}
+	for i := range roleRequests.Items {
+		rr := &roleRequests.Items[i]
+		if err := DumpObject(rr, folder, logger); err != nil {
+			return err
+		}
+		for j := range rr.Spec.RoleRefs {
+			addReference(references, rr.Spec.RoleRefs[j].Kind, rr.Spec.RoleRefs[j].Namespace,
+				rr.Spec.RoleRefs[j].Name)
+		}
+	}
+
+	eventTriggers, err := instance.ListEventTriggers(ctx, logger)
+	if err != nil {
+		return err
+	}
+	for i := range eventTriggers.Items {
+		et := &eventTriggers.Items[i]
+		if err := DumpObject(et, folder, logger); err != nil {
+			return err
+		}
+		// Empty namespace in an EventTrigger means the namespace of the matching cluster,
+		// which is only known at instantiation time
+		for j := range et.Spec.PolicyRefs {
+			addReference(references, et.Spec.PolicyRefs[j].Kind, et.Spec.PolicyRefs[j].Namespace,
+				et.Spec.PolicyRefs[j].Name)
+		}
+		for j := range et.Spec.KustomizationRefs {
+			addReference(references, et.Spec.KustomizationRefs[j].Kind, et.Spec.KustomizationRefs[j].Namespace,
+				et.Spec.KustomizationRefs[j].Name)
+		}
+	}
+
+	return collectReferences(ctx, references, folder, logger)
+}
+
+func getMatchingClusterNamespaces(refs []corev1.ObjectReference) []string {
+	namespaces := make([]string, 0)
+	seen := make(map[string]bool)
+	for i := range refs {
+		if !seen[refs[i].Namespace] {
+			seen[refs[i].Namespace] = true
+			namespaces = append(namespaces, refs[i].Namespace)
+		}
+	}
+	return namespaces
+}
+
+// addProfileReferences adds the ConfigMaps/Secrets referenced by a ClusterProfile/Profile.
+// defaultNamespaces are used for references with no namespace.
+func addProfileReferences(references map[reference]bool, spec *configv1beta1.Spec, defaultNamespaces []string) {
+	add := func(kind, namespace, name string) {
+		if namespace != "" {
+			addReference(references, kind, namespace, name)
+			return
+		}
+		for i := range defaultNamespaces {
+			addReference(references, kind, defaultNamespaces[i], name)
+		}
+	}
+
+	for i := range spec.PolicyRefs {
+		add(spec.PolicyRefs[i].Kind, spec.PolicyRefs[i].Namespace, spec.PolicyRefs[i].Name)
+	}
+	for i := range spec.KustomizationRefs {
+		add(spec.KustomizationRefs[i].Kind, spec.KustomizationRefs[i].Namespace, spec.KustomizationRefs[i].Name)
+	}
+}
+
+// addReference adds a ConfigMap/Secret reference. Other kinds (for instance Flux sources),
+// references with no namespace and templated references are ignored.
+func addReference(references map[reference]bool, kind, namespace, name string) {
+	if kind != string(libsveltosv1beta1.ConfigMapReferencedResourceKind) &&
+		kind != string(libsveltosv1beta1.SecretReferencedResourceKind) {
+
+		return
+	}
+	if namespace == "" || strings.Contains(namespace, "{{") || strings.Contains(name, "{{") {
+		return
+	}
+	references[reference{kind: kind, namespace: namespace, name: name}] = true
+}
+
+func collectReferences(ctx context.Context, references map[reference]bool, folder string,
+	logger logr.Logger) error {
+
+	instance := utils.GetAccessInstance()
+
+	for ref := range references {
+		var obj client.Object
+		if ref.kind == string(libsveltosv1beta1.ConfigMapReferencedResourceKind) {
+			obj = &corev1.ConfigMap{}
+		} else {
+			obj = &corev1.Secret{}
+		}
+
+		err := instance.GetResource(ctx, client.ObjectKey{Namespace: ref.namespace, Name: ref.name}, obj)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				logger.V(logs.LogDebug).Info(fmt.Sprintf("%s %s/%s not found", ref.kind, ref.namespace, ref.name))
+				continue
+			}
+			return err
+		}
+
+		if err := DumpObject(obj, folder, logger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/collector"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Collector", func() {
+	It("CollectSveltosConfiguration stores profiles and referenced ConfigMaps/Secrets", func() {
+		clusterNamespace := randomString()
+
+		referenced := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+			},
+			Data: map[string]string{"policy.yaml": randomString()},
+		}
+
+		notReferenced := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+			},
+		}
+
+		clusterProfile := &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: configv1beta1.Spec{
+				PolicyRefs: []configv1beta1.PolicyRef{
+					{
+						// Namespace not set: namespace of matching clusters is used
+						Kind: string(libsveltosv1beta1.ConfigMapReferencedResourceKind),
+						Name: referenced.Name,
+					},
+					{
+						Kind:      string(libsveltosv1beta1.SecretReferencedResourceKind),
+						Namespace: randomString(),
+						Name:      randomString(),
+					},
+				},
+			},
+			Status: configv1beta1.Status{
+				MatchingClusterRefs: []corev1.ObjectReference{
+					{
+						Kind:       libsveltosv1beta1.SveltosClusterKind,
+						APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace:  clusterNamespace,
+						Name:       randomString(),
+					},
+				},
+			},
+		}
+
+		classifier := &libsveltosv1beta1.Classifier{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+		}
+
+		initializeAccess(referenced, notReferenced, clusterProfile, classifier)
+
+		folder := GinkgoT().TempDir()
+		Expect(collector.CollectSveltosConfiguration(context.TODO(), folder,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))).To(Succeed())
+
+		_, err := os.Stat(filepath.Join(folder, configv1beta1.ClusterProfileKind, clusterProfile.Name+".yaml"))
+		Expect(err).To(BeNil())
+		_, err = os.Stat(filepath.Join(folder, libsveltosv1beta1.ClassifierKind, classifier.Name+".yaml"))
+		Expect(err).To(BeNil())
+		_, err = os.Stat(filepath.Join(folder, "ConfigMap", clusterNamespace, referenced.Name+".yaml"))
+		Expect(err).To(BeNil())
+		_, err = os.Stat(filepath.Join(folder, "Secret", clusterNamespace, notReferenced.Name+".yaml"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		objects, err := collector.LoadObjects(folder)
+		Expect(err).To(BeNil())
+		Expect(objects).To(HaveLen(3))
+		for i := range objects {
+			if objects[i].GetKind() == "ConfigMap" {
+				Expect(objects[i].GetName()).To(Equal(referenced.Name))
+				Expect(objects[i].GetAPIVersion()).To(Equal("v1"))
+				data := objects[i].Object["data"].(map[string]interface{})
+				Expect(data["policy.yaml"]).To(Equal(referenced.Data["policy.yaml"]))
+			}
+		}
+	})
+})
+
+func initializeAccess(objects ...client.Object) {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+}
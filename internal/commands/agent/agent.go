@@ -0,0 +1,106 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/snapshotter"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+// runAgent starts the controllers sveltosctl runs when deployed in the management cluster.
+// It blocks till ctx is cancelled or a termination signal is received.
+func runAgent(ctx context.Context, logger logr.Logger) error {
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	instance := utils.GetAccessInstance()
+
+	mgr, err := ctrl.NewManager(instance.GetConfig(), ctrl.Options{
+		Scheme: instance.GetScheme(),
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create manager: %w", err)
+	}
+
+	if err := (&snapshotter.SnapshotReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to create Snapshot controller: %w", err)
+	}
+
+	logger.V(logs.LogInfo).Info("starting agent")
+	return mgr.Start(ctx)
+}
+
+// Agent runs sveltosctl in long-running mode, collecting Sveltos configuration
+// snapshots on the schedule defined by each Snapshot instance.
+func Agent(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl agent [options] [--verbose]
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The agent command runs sveltosctl in long-running mode, as done by the sveltosctl
+  StatefulSet in the management cluster. For each Snapshot instance, Sveltos configuration
+  (ClusterProfiles, Profiles, referenced ConfigMaps/Secrets, Classifiers, RoleRequests and
+  EventTriggers) is stored on disk, in the Snapshot storage, on the Snapshot schedule.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	return runAgent(ctx, logger)
+}
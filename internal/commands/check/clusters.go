@@ -0,0 +1,386 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+
+	reachableYes = "yes"
+	reachableNo  = "no"
+	notAvailable = "-"
+	never        = "never"
+	none         = "none"
+
+	// rulesReviewNamespace is the namespace SelfSubjectRulesReview is evaluated in.
+	// Cluster wide rules are returned regardless of the namespace.
+	rulesReviewNamespace = "projectsveltos"
+)
+
+// requiredPermission is a permission Sveltos needs in a managed cluster
+type requiredPermission struct {
+	apiGroup string
+	resource string
+	verbs    []string
+}
+
+var (
+	genCheckHeader = func() []string {
+		return []string{"CLUSTER", "REACHABLE", "VERSION", "TOKEN EXPIRATION", "RBAC GAPS", "MESSAGE"}
+	}
+
+	allVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+	// requiredPermissions are the permissions Sveltos needs to deploy its agents and add-ons
+	requiredPermissions = []requiredPermission{
+		{apiGroup: "", resource: "namespaces", verbs: allVerbs},
+		{apiGroup: "", resource: "serviceaccounts", verbs: allVerbs},
+		{apiGroup: "", resource: "configmaps", verbs: allVerbs},
+		{apiGroup: "", resource: "secrets", verbs: allVerbs},
+		{apiGroup: "apps", resource: "deployments", verbs: allVerbs},
+		{apiGroup: "rbac.authorization.k8s.io", resource: "clusterroles", verbs: allVerbs},
+		{apiGroup: "rbac.authorization.k8s.io", resource: "clusterrolebindings", verbs: allVerbs},
+		{apiGroup: "apiextensions.k8s.io", resource: "customresourcedefinitions", verbs: allVerbs},
+	}
+
+	// getClientset returns the clientset used to access a managed cluster
+	getClientset = func(restConfig *rest.Config) (kubernetes.Interface, error) {
+		return kubernetes.NewForConfig(restConfig)
+	}
+)
+
+// checkResult is the outcome of the check of a SveltosCluster
+type checkResult struct {
+	cluster         string
+	reachable       string
+	version         string
+	tokenExpiration string
+	rbacGaps        string
+	message         string
+	// skipped is set for clusters which cannot be checked from the management cluster
+	skipped bool
+}
+
+func (r *checkResult) failed() bool {
+	return !r.skipped && (r.reachable != reachableYes || r.rbacGaps != none)
+}
+
+// checkCluster verifies the kubeconfig stored for a SveltosCluster can be used to reach the
+// managed cluster and grants the permissions Sveltos needs
+func checkCluster(ctx context.Context, cluster *libsveltosv1beta1.SveltosCluster, timeout time.Duration,
+	logger logr.Logger) checkResult {
+
+	result := checkResult{
+		cluster:         fmt.Sprintf("%s/%s", cluster.Namespace, cluster.Name),
+		reachable:       reachableNo,
+		version:         notAvailable,
+		tokenExpiration: notAvailable,
+		rbacGaps:        notAvailable,
+	}
+
+	logger = logger.WithValues("cluster", result.cluster)
+
+	if cluster.Spec.PullMode {
+		result.skipped = true
+		result.reachable = notAvailable
+		result.message = "cluster is in pull mode: it connects to the management cluster"
+		return result
+	}
+
+	logger.V(logs.LogDebug).Info("get kubeconfig")
+	data, err := clusterproxy.GetSveltosSecretData(ctx, logger, utils.GetAccessInstance().GetClient(),
+		cluster.Namespace, cluster.Name)
+	if err != nil {
+		result.message = err.Error()
+		return result
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		result.message = fmt.Sprintf("failed to parse kubeconfig: %v", err)
+		return result
+	}
+	restConfig.Timeout = timeout
+
+	result.tokenExpiration = never
+	if restConfig.BearerToken != "" {
+		expiration, err := utils.GetTokenExpiration(restConfig.BearerToken)
+		if err != nil {
+			result.tokenExpiration = notAvailable
+		} else if expiration != nil {
+			result.tokenExpiration = expiration.UTC().Format(time.RFC3339)
+			if !expiration.After(time.Now()) {
+				result.message = "token expired"
+			}
+		}
+	}
+
+	clientset, err := getClientset(restConfig)
+	if err != nil {
+		result.message = err.Error()
+		return result
+	}
+
+	logger.V(logs.LogDebug).Info("get server version")
+	version, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		result.message = fmt.Sprintf("discovery failed: %v", err)
+		return result
+	}
+	result.reachable = reachableYes
+	result.version = version.GitVersion
+
+	logger.V(logs.LogDebug).Info("review permissions")
+	gaps, incomplete, err := getRBACGaps(ctx, clientset, timeout)
+	if err != nil {
+		result.message = fmt.Sprintf("failed to review permissions: %v", err)
+		return result
+	}
+	result.rbacGaps = none
+	if len(gaps) != 0 {
+		result.rbacGaps = strings.Join(gaps, "\n")
+	}
+	if incomplete {
+		result.message = "permission review is incomplete: RBAC gaps confirmed with SelfSubjectAccessReviews"
+	}
+
+	return result
+}
+
+// getRBACGaps returns the permissions Sveltos needs which are not granted in the managed cluster.
+// incomplete is true when the cluster cannot enumerate all rules (for instance with webhook
+// authorizers). In that case each gap is confirmed with a SelfSubjectAccessReview.
+func getRBACGaps(ctx context.Context, clientset kubernetes.Interface, timeout time.Duration,
+) (gaps []string, incomplete bool, err error) {
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	review := &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: rulesReviewNamespace},
+	}
+	review, err = clientset.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	gaps = make([]string, 0)
+	for i := range requiredPermissions {
+		permission := &requiredPermissions[i]
+		missing := make([]string, 0)
+		for _, verb := range permission.verbs {
+			if isAllowed(review.Status.ResourceRules, permission.apiGroup, permission.resource, verb) {
+				continue
+			}
+			if review.Status.Incomplete {
+				allowed, err := isAllowedByAccessReview(ctx, clientset, permission.apiGroup, permission.resource, verb)
+				if err != nil {
+					return nil, true, err
+				}
+				if allowed {
+					continue
+				}
+			}
+			missing = append(missing, verb)
+		}
+		if len(missing) != 0 {
+			resource := permission.resource
+			if permission.apiGroup != "" {
+				resource = fmt.Sprintf("%s.%s", permission.resource, permission.apiGroup)
+			}
+			gaps = append(gaps, fmt.Sprintf("%s: %s", resource, strings.Join(missing, ",")))
+		}
+	}
+
+	return gaps, review.Status.Incomplete, nil
+}
+
+// isAllowedByAccessReview returns true if verb on apiGroup/resource is granted in all namespaces
+func isAllowedByAccessReview(ctx context.Context, clientset kubernetes.Interface, apiGroup, resource, verb string,
+) (bool, error) {
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    apiGroup,
+				Resource: resource,
+				Verb:     verb,
+			},
+		},
+	}
+	review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+// isAllowed returns true if any of the rules grants verb on apiGroup/resource
+func isAllowed(rules []authorizationv1.ResourceRule, apiGroup, resource, verb string) bool {
+	matches := func(values []string, value string) bool {
+		return slices.Contains(values, "*") || slices.Contains(values, value)
+	}
+
+	for i := range rules {
+		if matches(rules[i].APIGroups, apiGroup) && matches(rules[i].Resources, resource) &&
+			matches(rules[i].Verbs, verb) {
+
+			return true
+		}
+	}
+	return false
+}
+
+// checkClusters checks all SveltosClusters (only the ones in namespace if not empty, only
+// the one named clusterName if not empty) and writes a report to out. An error is returned
+// if any cluster is not reachable or misses permissions.
+func checkClusters(ctx context.Context, namespace, clusterName string, timeout time.Duration,
+	out io.Writer, logger logr.Logger) error {
+
+	instance := utils.GetAccessInstance()
+
+	listOptions := []client.ListOption{}
+	if namespace != "" {
+		listOptions = append(listOptions, client.InNamespace(namespace))
+	}
+
+	sveltosClusters := &libsveltosv1beta1.SveltosClusterList{}
+	if err := instance.ListResources(ctx, sveltosClusters, listOptions...); err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(out)
+	table.Header(genCheckHeader())
+
+	failed := 0
+	for i := range sveltosClusters.Items {
+		cluster := &sveltosClusters.Items[i]
+		if clusterName != "" && cluster.Name != clusterName {
+			continue
+		}
+
+		result := checkCluster(ctx, cluster, timeout, logger)
+		if result.failed() {
+			failed++
+		}
+		if err := table.Append([]string{result.cluster, result.reachable, result.version,
+			result.tokenExpiration, result.rbacGaps, result.message}); err != nil {
+			return err
+		}
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("%d clusters failed the check", failed)
+	}
+	return nil
+}
+
+// Clusters checks connectivity and permissions of registered clusters
+func Clusters(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl check clusters [options] [--namespace=<name>] [--cluster=<name>] [--timeout=<duration>] [--verbose]
+
+     --namespace=<name>      Check only SveltosClusters in this namespace.
+                             If not specified all namespaces are considered.
+     --cluster=<name>        Check only SveltosClusters with this name.
+                             If not specified all SveltosClusters are considered.
+     --timeout=<duration>    Timeout for each request to a managed cluster. Default: 10s.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The check clusters command loads the kubeconfig stored for each SveltosCluster and, using it:
+  - verifies the managed API server is reachable and reports its version;
+  - reports when the kubeconfig token expires;
+  - verifies, with a SelfSubjectRulesReview, the permissions Sveltos needs are granted and
+    reports the missing ones. When the review is incomplete (for instance with webhook
+    authorizers), each missing permission is confirmed with a SelfSubjectAccessReview.
+  SveltosClusters in pull mode are not reachable from the management cluster and are not checked.
+  The command fails if any cluster is not reachable or misses permissions.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	namespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		namespace = passedNamespace.(string)
+	}
+
+	cluster := ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		cluster = passedCluster.(string)
+	}
+
+	timeout := defaultTimeout
+	if passedTimeout := parsedArgs["--timeout"]; passedTimeout != nil {
+		timeout, err = time.ParseDuration(passedTimeout.(string))
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid timeout %q: must be a positive duration", passedTimeout.(string))
+		}
+	}
+
+	return checkClusters(ctx, namespace, cluster, timeout, os.Stdout, logger)
+}
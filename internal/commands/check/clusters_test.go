@@ -0,0 +1,189 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package check_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/check"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	kubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: local
+  cluster:
+    server: https://managed:6443
+users:
+- name: projectsveltos
+  user:
+    token: %s
+contexts:
+- name: sveltos-context
+  context:
+    cluster: local
+    user: projectsveltos
+current-context: sveltos-context
+`
+	kubeconfigKey = "kubeconfig"
+	gitVersion    = "v1.35.0"
+)
+
+var _ = Describe("Check clusters", func() {
+	var rules []authorizationv1.ResourceRule
+	var incomplete bool
+	var allowedVerbs []string
+
+	BeforeEach(func() {
+		rules = nil
+		incomplete = false
+		allowedVerbs = nil
+
+		clientset := fakeclientset.NewClientset()
+		clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: gitVersion}
+		clientset.PrependReactor("create", "selfsubjectrulesreviews",
+			func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &authorizationv1.SelfSubjectRulesReview{
+					Status: authorizationv1.SubjectRulesReviewStatus{ResourceRules: rules, Incomplete: incomplete},
+				}, nil
+			})
+		clientset.PrependReactor("create", "selfsubjectaccessreviews",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				review.Status.Allowed = slices.Contains(allowedVerbs, review.Spec.ResourceAttributes.Verb)
+				return true, review, nil
+			})
+		check.SetClientset(clientset)
+	})
+
+	It("isAllowed matches wildcards", func() {
+		rules := []authorizationv1.ResourceRule{
+			{APIGroups: []string{"apps"}, Resources: []string{"*"}, Verbs: []string{"get", "list"}},
+		}
+		Expect(check.IsAllowed(rules, "apps", "deployments", "get")).To(BeTrue())
+		Expect(check.IsAllowed(rules, "apps", "deployments", "delete")).To(BeFalse())
+		Expect(check.IsAllowed(rules, "", "secrets", "get")).To(BeFalse())
+	})
+
+	It("checkClusters reports reachability, version and RBAC gaps", func() {
+		cluster, secret := getSveltosCluster()
+		pullModeCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: cluster.Namespace, Name: randomString()},
+			Spec:       libsveltosv1beta1.SveltosClusterSpec{PullMode: true},
+		}
+		initializeAccess(cluster, secret, pullModeCluster)
+
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+
+		// cluster-admin
+		rules = []authorizationv1.ResourceRule{
+			{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+		}
+		var out bytes.Buffer
+		Expect(check.CheckClusters(context.TODO(), cluster.Namespace, "", time.Second, &out, logger)).To(Succeed())
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf(`%s .*│ yes .*│ %s .*│ never .*│ none`,
+			cluster.Name, gitVersion)))
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf(`%s .*│ cluster is in pull mode`, pullModeCluster.Name)))
+
+		// no permission on CustomResourceDefinitions
+		rules = []authorizationv1.ResourceRule{
+			{APIGroups: []string{"", "apps", "rbac.authorization.k8s.io"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+			{APIGroups: []string{"apiextensions.k8s.io"}, Resources: []string{"*"}, Verbs: []string{"get", "list", "watch"}},
+		}
+		out.Reset()
+		Expect(check.CheckClusters(context.TODO(), cluster.Namespace, cluster.Name, time.Second, &out,
+			logger)).ToNot(Succeed())
+		Expect(out.String()).To(ContainSubstring("customresourcedefinitions.apiextensions.k8s.io:"))
+		Expect(out.String()).To(ContainSubstring("create,update,patch,delete"))
+		Expect(out.String()).ToNot(ContainSubstring(pullModeCluster.Name))
+	})
+
+	It("checkClusters confirms RBAC gaps with SelfSubjectAccessReviews when the rules review is incomplete", func() {
+		cluster, secret := getSveltosCluster()
+		initializeAccess(cluster, secret)
+
+		rules = []authorizationv1.ResourceRule{
+			{APIGroups: []string{"", "apps", "rbac.authorization.k8s.io"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+		}
+		incomplete = true
+		// Granted by an authorizer the rules review cannot enumerate
+		allowedVerbs = []string{"get", "list", "watch", "create", "update", "patch"}
+
+		var out bytes.Buffer
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		Expect(check.CheckClusters(context.TODO(), cluster.Namespace, cluster.Name, time.Second, &out,
+			logger)).ToNot(Succeed())
+		Expect(out.String()).To(ContainSubstring("customresourcedefinitions.apiextensions.k8s.io: delete"))
+
+		allowedVerbs = append(allowedVerbs, "delete")
+		out.Reset()
+		Expect(check.CheckClusters(context.TODO(), cluster.Namespace, cluster.Name, time.Second, &out,
+			logger)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("incomplete"))
+	})
+
+	It("checkClusters reports clusters with no kubeconfig", func() {
+		cluster, _ := getSveltosCluster()
+		initializeAccess(cluster)
+
+		var out bytes.Buffer
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		Expect(check.CheckClusters(context.TODO(), "", "", time.Second, &out, logger)).ToNot(Succeed())
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf(`%s .*│ no `, cluster.Name)))
+	})
+})
+
+func getSveltosCluster() (*libsveltosv1beta1.SveltosCluster, *corev1.Secret) {
+	cluster := &libsveltosv1beta1.SveltosCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: randomString(), Name: randomString()},
+		Spec:       libsveltosv1beta1.SveltosClusterSpec{KubeconfigKeyName: kubeconfigKey},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: cluster.Namespace, Name: cluster.Name + "-sveltos-kubeconfig"},
+		Data:       map[string][]byte{kubeconfigKey: []byte(fmt.Sprintf(kubeconfigTemplate, randomString()))},
+	}
+	return cluster, secret
+}
+
+func initializeAccess(objects ...client.Object) {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+}
@@ -22,12 +22,14 @@ import (
 	"strings"
 
 	docopt "github.com/docopt/docopt-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
 func updateDebuggingConfiguration(ctx context.Context, logSeverity libsveltosv1beta1.LogLevel,
-	component string) error {
+	component string, opts ...client.UpdateOption) error {
 
 	cc, err := collectLogLevelConfiguration(ctx)
 	if err != nil {
@@ -62,19 +64,22 @@ func updateDebuggingConfiguration(ctx context.Context, logSeverity libsveltosv1b
 		)
 	}
 
-	return updateLogLevelConfiguration(ctx, spec)
+	return updateLogLevelConfiguration(ctx, spec, opts...)
 }
 
 // Set displays/changes log verbosity for a given component
 func Set(ctx context.Context, args []string) error {
 	doc := `Usage:
-  sveltosctl log-level set --component=<name> (--info|--debug|--verbose)
+  sveltosctl log-level set --component=<name> (--info|--debug|--verbose) [--dry-run=<mode>] [--yes]
 Options:
   -h --help             Show this screen.
      --component=<name> Name of the component for which log severity is being set.
      --info             Set log severity to info.
      --debug            Set log severity to debug.
      --verbose          Set log severity to verbose.
+     --dry-run=<mode>   Print the change without applying it. Accepted values: client (only print),
+                        server (also submit the change to the API server in dry-run mode) and none.
+     --yes              Apply the change without asking for confirmation.
 	 
 Description:
   The log-level set command set log severity for the specified component.
@@ -108,5 +113,15 @@ Description:
 		logSeverity = libsveltosv1beta1.LogLevelVerbose
 	}
 
-	return updateDebuggingConfiguration(ctx, logSeverity, component)
+	dryRun, err := utils.ParseDryRun(parsedArgs["--dry-run"])
+	if err != nil {
+		return err
+	}
+
+	apply, opts, err := confirmLogLevelChange(ctx, component, logSeverity, dryRun, parsedArgs["--yes"].(bool))
+	if err != nil || !apply {
+		return err
+	}
+
+	return updateDebuggingConfiguration(ctx, logSeverity, component, opts...)
 }
@@ -21,6 +21,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
@@ -59,4 +61,17 @@ var _ = Describe("Set", func() {
 		Expect(currentDC.Spec.Configuration[0].Component).To(Equal(libsveltosv1beta1.ComponentAddonManager))
 		Expect(currentDC.Spec.Configuration[0].LogLevel).To(Equal(libsveltosv1beta1.LogLevelInfo))
 	})
+
+	It("set in server dry-run mode does not change DebuggingConfiguration", func() {
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+		Expect(loglevel.UpdateDebuggingConfiguration(context.TODO(), libsveltosv1beta1.LogLevelDebug,
+			string(libsveltosv1beta1.ComponentAddonManager), client.DryRunAll)).To(Succeed())
+
+		_, err = utils.GetAccessInstance().GetDebuggingConfiguration(context.TODO())
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
 })
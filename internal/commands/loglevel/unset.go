@@ -22,11 +22,13 @@ import (
 	"strings"
 
 	docopt "github.com/docopt/docopt-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
-func unsetDebuggingConfiguration(ctx context.Context, component string) error {
+func unsetDebuggingConfiguration(ctx context.Context, component string, opts ...client.UpdateOption) error {
 	cc, err := collectLogLevelConfiguration(ctx)
 	if err != nil {
 		return nil
@@ -50,7 +52,7 @@ func unsetDebuggingConfiguration(ctx context.Context, component string) error {
 	}
 
 	if found {
-		return updateLogLevelConfiguration(ctx, spec)
+		return updateLogLevelConfiguration(ctx, spec, opts...)
 	}
 	return nil
 }
@@ -58,10 +60,13 @@ func unsetDebuggingConfiguration(ctx context.Context, component string) error {
 // Unset resets log verbosity for a given component
 func Unset(ctx context.Context, args []string) error {
 	doc := `Usage:
-  sveltosctl log-level unset --component=<name>
+  sveltosctl log-level unset --component=<name> [--dry-run=<mode>] [--yes]
 Options:
   -h --help             Show this screen.
      --component=<name> Name of the component for which log severity is being set.
+     --dry-run=<mode>   Print the change without applying it. Accepted values: client (only print),
+                        server (also submit the change to the API server in dry-run mode) and none.
+     --yes              Apply the change without asking for confirmation.
 	 
 Description:
   The log-level set command set log severity for the specified component.
@@ -82,5 +87,15 @@ Description:
 		component = passedComponent.(string)
 	}
 
-	return unsetDebuggingConfiguration(ctx, component)
+	dryRun, err := utils.ParseDryRun(parsedArgs["--dry-run"])
+	if err != nil {
+		return err
+	}
+
+	apply, opts, err := confirmLogLevelChange(ctx, component, "", dryRun, parsedArgs["--yes"].(bool))
+	if err != nil || !apply {
+		return err
+	}
+
+	return unsetDebuggingConfiguration(ctx, component, opts...)
 }
@@ -18,10 +18,13 @@ package loglevel
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"sort"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
 	"github.com/projectsveltos/sveltosctl/internal/utils"
@@ -70,6 +73,7 @@ func collectLogLevelConfiguration(ctx context.Context) ([]*componentConfiguratio
 func updateLogLevelConfiguration(
 	ctx context.Context,
 	spec []libsveltosv1beta1.ComponentConfiguration,
+	opts ...client.UpdateOption,
 ) error {
 
 	instance := utils.GetAccessInstance()
@@ -91,5 +95,52 @@ func updateLogLevelConfiguration(
 		Configuration: spec,
 	}
 
-	return instance.UpdateDebuggingConfiguration(ctx, dc)
+	return instance.UpdateDebuggingConfiguration(ctx, dc, opts...)
+}
+
+// confirmLogLevelChange prints the change to the log severity of component (an empty logSeverity
+// means the setting is removed) and asks for confirmation. Returns whether the change must be
+// applied and the options to apply it with.
+func confirmLogLevelChange(ctx context.Context, component string, logSeverity libsveltosv1beta1.LogLevel,
+	dryRun utils.DryRunMode, yes bool) (bool, []client.UpdateOption, error) {
+
+	cc, err := collectLogLevelConfiguration(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+
+	current := libsveltosv1beta1.LogLevel("")
+	for i := range cc {
+		if string(cc[i].component) == component {
+			current = cc[i].logSeverity
+			break
+		}
+	}
+
+	changes := []utils.Change{}
+	if current != logSeverity {
+		changes = append(changes, utils.Change{
+			Action: "update",
+			Object: "DebuggingConfiguration/default",
+			Details: fmt.Sprintf("%s: %s -> %s", component, getLogLevelDescription(current),
+				getLogLevelDescription(logSeverity)),
+		})
+	}
+
+	apply, err := utils.ConfirmChanges(os.Stdin, os.Stdout, changes, dryRun, yes)
+	if err != nil || !apply {
+		return false, nil, err
+	}
+
+	if dryRun == utils.DryRunServer {
+		return true, []client.UpdateOption{client.DryRunAll}, nil
+	}
+	return true, nil, nil
+}
+
+func getLogLevelDescription(logSeverity libsveltosv1beta1.LogLevel) string {
+	if logSeverity == "" {
+		return "not set"
+	}
+	return string(logSeverity)
 }
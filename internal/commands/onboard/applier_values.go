@@ -0,0 +1,244 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	applierNamespace = "projectsveltos"
+
+	//nolint: gosec // name of the ConfigMap with the extra CA bundle
+	caBundleConfigMapName = "sveltos-applier-ca-bundle"
+	caBundleKey           = "ca-bundle.crt"
+	caBundleVolumeName    = "extra-ca-bundle"
+	caBundleMountPath     = "/etc/sveltos/ca-certificates"
+	// systemCertsDir is where the applier image stores the system CA certificates
+	systemCertsDir = "/etc/ssl/certs"
+)
+
+// applierValues customizes the sveltos-applier manifest deployed in a cluster registered
+// in pull mode. Unset fields leave the default manifest untouched.
+type applierValues struct {
+	// Image of the sveltos-applier container
+	Image *applierImage `json:"image,omitempty"`
+
+	// Resources of the sveltos-applier container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// NodeSelector of the sveltos-applier pod
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations of the sveltos-applier pod
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Proxy used by sveltos-applier to reach the management cluster
+	Proxy *applierProxy `json:"proxy,omitempty"`
+
+	// ExtraCABundle contains, in PEM format, CA certificates trusted by sveltos-applier
+	// in addition to the system ones
+	ExtraCABundle string `json:"extraCABundle,omitempty"`
+
+	// ClusterRoleRules, if set, replace the rules of the sveltos-applier ClusterRole, which
+	// by default grants all permissions
+	ClusterRoleRules []rbacv1.PolicyRule `json:"clusterRoleRules,omitempty"`
+}
+
+// applierImage overrides parts of the sveltos-applier image
+type applierImage struct {
+	// Registry replaces the registry of the default image (for instance registry.internal:5000)
+	Registry string `json:"registry,omitempty"`
+
+	// Repository replaces the repository of the default image (for instance projectsveltos/sveltos-applier)
+	Repository string `json:"repository,omitempty"`
+
+	// Tag of the image. Tag and Digest are mutually exclusive.
+	Tag string `json:"tag,omitempty"`
+
+	// Digest of the image (for instance sha256:...). Tag and Digest are mutually exclusive.
+	Digest string `json:"digest,omitempty"`
+}
+
+// applierProxy contains the proxy settings of sveltos-applier
+type applierProxy struct {
+	HTTPProxy  string `json:"httpProxy,omitempty"`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	NoProxy    string `json:"noProxy,omitempty"`
+}
+
+// loadApplierValues reads sveltos-applier customizations from a YAML file
+func loadApplierValues(fileName string) (*applierValues, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &applierValues{}
+	if err := yaml.UnmarshalStrict(data, values); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+
+	if values.Image != nil && values.Image.Tag != "" && values.Image.Digest != "" {
+		return nil, errors.New("image tag and digest are mutually exclusive")
+	}
+
+	return values, nil
+}
+
+// customizeImage returns image with registry, repository, tag and digest replaced as requested
+func (i *applierImage) customizeImage(image string) string {
+	name, reference, separator := image, "", ""
+	if index := strings.Index(name, "@"); index != -1 {
+		name, reference, separator = image[:index], image[index+1:], "@"
+	} else if index := strings.LastIndex(name, ":"); index > strings.LastIndex(name, "/") {
+		name, reference, separator = image[:index], image[index+1:], ":"
+	}
+
+	registry, repository := "", name
+	if index := strings.Index(name, "/"); index != -1 {
+		host := name[:index]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			registry, repository = host, name[index+1:]
+		}
+	}
+
+	if i.Registry != "" {
+		registry = i.Registry
+	}
+	if i.Repository != "" {
+		repository = i.Repository
+	}
+	switch {
+	case i.Digest != "":
+		reference, separator = i.Digest, "@"
+	case i.Tag != "":
+		reference, separator = i.Tag, ":"
+	}
+
+	result := repository
+	if registry != "" {
+		result = registry + "/" + repository
+	}
+	if reference != "" {
+		result += separator + reference
+	}
+	return result
+}
+
+// customizeDeployment applies values to the sveltos-applier Deployment
+func (v *applierValues) customizeDeployment(depl *appsv1.Deployment) {
+	podSpec := &depl.Spec.Template.Spec
+
+	if v.NodeSelector != nil {
+		podSpec.NodeSelector = v.NodeSelector
+	}
+	if v.Tolerations != nil {
+		podSpec.Tolerations = v.Tolerations
+	}
+	if v.ExtraCABundle != "" {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: caBundleVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: caBundleConfigMapName},
+				},
+			},
+		})
+	}
+
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		if container.Name != "controller" {
+			continue
+		}
+
+		if v.Image != nil {
+			container.Image = v.Image.customizeImage(container.Image)
+		}
+		if v.Resources != nil {
+			container.Resources = *v.Resources
+		}
+		if v.Proxy != nil {
+			container.Env = appendEnv(container.Env, "HTTP_PROXY", v.Proxy.HTTPProxy)
+			container.Env = appendEnv(container.Env, "HTTPS_PROXY", v.Proxy.HTTPSProxy)
+			container.Env = appendEnv(container.Env, "NO_PROXY", v.Proxy.NoProxy)
+		}
+		if v.ExtraCABundle != "" {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      caBundleVolumeName,
+				MountPath: caBundleMountPath,
+				ReadOnly:  true,
+			})
+			// Go reads CA certificates from all directories listed in SSL_CERT_DIR
+			container.Env = appendEnv(container.Env, "SSL_CERT_DIR",
+				fmt.Sprintf("%s:%s", systemCertsDir, caBundleMountPath))
+		}
+	}
+}
+
+// customizeClusterRole applies values to the sveltos-applier ClusterRole
+func (v *applierValues) customizeClusterRole(clusterRole *rbacv1.ClusterRole) {
+	if v.ClusterRoleRules != nil {
+		clusterRole.Rules = v.ClusterRoleRules
+	}
+}
+
+// getCABundleConfigMap returns the ConfigMap containing the extra CA bundle, nil if none is set
+func (v *applierValues) getCABundleConfigMap() *corev1.ConfigMap {
+	if v.ExtraCABundle == "" {
+		return nil
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: applierNamespace,
+			Name:      caBundleConfigMapName,
+		},
+		Data: map[string]string{
+			caBundleKey: v.ExtraCABundle,
+		},
+	}
+}
+
+// appendEnv sets an environment variable, if value is not empty
+func appendEnv(env []corev1.EnvVar, name, value string) []corev1.EnvVar {
+	if value == "" {
+		return env
+	}
+	for i := range env {
+		if env[i].Name == name {
+			env[i].Value = value
+			env[i].ValueFrom = nil
+			return env
+		}
+	}
+	return append(env, corev1.EnvVar{Name: name, Value: value})
+}
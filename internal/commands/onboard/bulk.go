@@ -0,0 +1,445 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboard
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/commands/generate"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	defaultConcurrency      = 5
+	defaultClusterNamespace = "default"
+
+	resultFailed = "failed"
+)
+
+// clusterRegistration describes a cluster to register when using register clusters --from-file
+type clusterRegistration struct {
+	// Namespace of the SveltosCluster. If not set, the default namespace is used.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the SveltosCluster
+	Name string `json:"name"`
+
+	// Kubeconfig is the path to a file containing the kubeconfig of the cluster.
+	// Either Kubeconfig or Context must be set.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+
+	// Context is the name of a context in the kubeconfig (the one passed with --kubeconfig,
+	// or the default one) pointing to the cluster. Sveltos generates a kubeconfig for it.
+	Context string `json:"context,omitempty"`
+
+	// Labels to set on the SveltosCluster
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ServiceAccountToken, valid only with Context, generates a non-expiring token
+	// instead of a renewed TokenRequest
+	ServiceAccountToken bool `json:"serviceAccountToken,omitempty"`
+}
+
+// clusterRegistrations is the content of the file passed to register clusters --from-file
+type clusterRegistrations struct {
+	Clusters []clusterRegistration `json:"clusters"`
+}
+
+// registrationResult is the outcome of a cluster registration
+type registrationResult struct {
+	cluster string
+	source  string
+	result  string
+	message string
+}
+
+var (
+	genRegistrationHeader = func() []string {
+		return []string{"CLUSTER", "SOURCE", "RESULT", "MESSAGE"}
+	}
+)
+
+// loadClusterRegistrations reads and validates the clusters to register from a file
+func loadClusterRegistrations(fileName string) ([]clusterRegistration, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	registrations := &clusterRegistrations{}
+	if err := yaml.UnmarshalStrict(data, registrations); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+
+	seen := make(map[string]bool)
+	for i := range registrations.Clusters {
+		registration := &registrations.Clusters[i]
+		if registration.Namespace == "" {
+			registration.Namespace = defaultClusterNamespace
+		}
+		if registration.Name == "" {
+			return nil, fmt.Errorf("entry %d: name must be specified", i)
+		}
+		if (registration.Kubeconfig == "") == (registration.Context == "") {
+			return nil, fmt.Errorf("cluster %s/%s: exactly one of kubeconfig and context must be specified",
+				registration.Namespace, registration.Name)
+		}
+		key := registration.Namespace + "/" + registration.Name
+		if seen[key] {
+			return nil, fmt.Errorf("cluster %s is listed more than once", key)
+		}
+		seen[key] = true
+	}
+
+	return registrations.Clusters, nil
+}
+
+// getAllContextRegistrations returns a registration for each context in the kubeconfig, but
+// the current one which points to the management cluster. Cluster name is the context name.
+func getAllContextRegistrations(kubeconfigFile, namespace string, labels map[string]string,
+	satoken bool) ([]clusterRegistration, error) {
+
+	config, err := getClientConfig(kubeconfigFile, "").RawConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	contexts := make([]string, 0, len(config.Contexts))
+	for contextName := range config.Contexts {
+		if contextName == config.CurrentContext {
+			continue
+		}
+		contexts = append(contexts, contextName)
+	}
+	sort.Strings(contexts)
+
+	registrations := make([]clusterRegistration, len(contexts))
+	for i := range contexts {
+		registrations[i] = clusterRegistration{
+			Namespace:           namespace,
+			Name:                contexts[i],
+			Context:             contexts[i],
+			Labels:              labels,
+			ServiceAccountToken: satoken,
+		}
+	}
+
+	return registrations, nil
+}
+
+// getClientConfig returns the client config for a context. If kubeconfigFile is empty, the
+// default loading rules are used. If contextName is empty the current context is used.
+// Differently from switchCurrentContext, the kubeconfig file is never modified, so this
+// is safe to use while registering clusters concurrently.
+func getClientConfig(kubeconfigFile, contextName string) clientcmd.ClientConfig {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfigFile != "" {
+		loadingRules.ExplicitPath = kubeconfigFile
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: contextName})
+}
+
+// registerClusters registers all clusters, at most concurrency at a time, and writes a summary
+// table to out. An error is returned if at least one registration failed.
+func registerClusters(ctx context.Context, registrations []clusterRegistration, kubeconfigFile string,
+	concurrency int, out io.Writer, logger logr.Logger) error {
+
+	results := make([]registrationResult, len(registrations))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for i := range registrations {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			results[i] = registerCluster(ctx, &registrations[i], kubeconfigFile, logger)
+		}(i)
+	}
+	wg.Wait()
+
+	table := tablewriter.NewWriter(out)
+	table.Header(genRegistrationHeader())
+
+	failed := 0
+	for i := range results {
+		if results[i].result == resultFailed {
+			failed++
+		}
+		if err := table.Append([]string{results[i].cluster, results[i].source,
+			results[i].result, results[i].message}); err != nil {
+			return err
+		}
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("%d out of %d clusters failed to register", failed, len(results))
+	}
+	return nil
+}
+
+func registerCluster(ctx context.Context, registration *clusterRegistration, kubeconfigFile string,
+	logger logr.Logger) registrationResult {
+
+	result := registrationResult{
+		cluster: registration.Namespace + "/" + registration.Name,
+		source:  registration.Kubeconfig,
+	}
+	if registration.Context != "" {
+		result.source = "context " + registration.Context
+	}
+
+	logger = logger.WithValues("cluster", result.cluster)
+	logger.V(logs.LogDebug).Info("registering cluster")
+
+	if errs := validation.IsDNS1123Subdomain(registration.Name); len(errs) != 0 {
+		result.result = resultFailed
+		result.message = fmt.Sprintf("invalid cluster name: %s", strings.Join(errs, ", "))
+		return result
+	}
+
+	data, renew, err := getRegistrationKubeconfig(ctx, registration, kubeconfigFile, logger)
+	if err != nil {
+		result.result = resultFailed
+		result.message = err.Error()
+		return result
+	}
+
+	var operation controllerutil.OperationResult
+	if data == nil {
+		// Cluster is already registered: only labels are reconciled
+		operation, err = patchSveltosCluster(ctx, registration.Namespace, registration.Name,
+			registration.Labels, renew, logger)
+	} else {
+		operation, err = registerSveltosCluster(ctx, registration.Namespace, registration.Name, data,
+			registration.Labels, renew, logger)
+	}
+	if err != nil {
+		result.result = resultFailed
+		result.message = err.Error()
+		return result
+	}
+
+	result.result = string(operation)
+	if operation == controllerutil.OperationResultNone {
+		result.result = "unchanged"
+	}
+	return result
+}
+
+// getRegistrationKubeconfig returns the kubeconfig to store for a cluster and whether Sveltos
+// needs to renew it.
+// For clusters registered from a context, a kubeconfig is generated only if the cluster is not
+// registered yet: this keeps reruns from minting new tokens. In that case nil data is returned.
+func getRegistrationKubeconfig(ctx context.Context, registration *clusterRegistration, kubeconfigFile string,
+	logger logr.Logger) (data []byte, renew bool, err error) {
+
+	if registration.Kubeconfig != "" {
+		data, err = os.ReadFile(registration.Kubeconfig)
+		return data, false, err
+	}
+
+	renew = !registration.ServiceAccountToken
+
+	registered, err := isClusterRegistered(ctx, registration.Namespace, registration.Name)
+	if err != nil {
+		return nil, renew, err
+	}
+	if registered {
+		logger.V(logs.LogDebug).Info("cluster already registered, kubeconfig is not regenerated")
+		return nil, renew, nil
+	}
+
+	restConfig, err := getClientConfig(kubeconfigFile, registration.Context).ClientConfig()
+	if err != nil {
+		return nil, renew, err
+	}
+
+	logger.V(logs.LogDebug).Info("Generate Kubeconfig")
+	kubeconfigData, err := generate.GenerateKubeconfigForServiceAccount(ctx, restConfig, generate.Projectsveltos,
+		generate.Projectsveltos, 0, true, false, registration.ServiceAccountToken, logger)
+	if err != nil {
+		return nil, renew, err
+	}
+
+	return []byte(kubeconfigData), renew, nil
+}
+
+// isClusterRegistered returns true if both the SveltosCluster and its kubeconfig Secret exist
+func isClusterRegistered(ctx context.Context, clusterNamespace, clusterName string) (bool, error) {
+	instance := utils.GetAccessInstance()
+
+	sveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+	err := instance.GetResource(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: clusterName},
+		sveltosCluster)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	secret := &corev1.Secret{}
+	err = instance.GetResource(ctx, types.NamespacedName{Namespace: clusterNamespace,
+		Name: clusterName + sveltosKubeconfigSecretNamePostfix}, secret)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RegisterClusters registers many clusters at once
+func RegisterClusters(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl register clusters [options] (--from-file=<file>|--all-contexts) [--kubeconfig=<file>]
+                                 [--namespace=<name>] [--labels=<value>] [--service-account-token]
+                                 [--concurrency=<value>] [--verbose]
+
+     --from-file=<file>                  Registers the clusters listed in the file. Format is:
+                                           clusters:
+                                           - namespace: <SveltosCluster namespace, default if not set>
+                                             name: <SveltosCluster name>
+                                             kubeconfig: <path to the cluster kubeconfig>
+                                             context: <context in the kubeconfig pointing to the cluster>
+                                             labels: <SveltosCluster labels>
+                                             serviceAccountToken: <true to use a non-expiring token>
+                                         Exactly one of kubeconfig and context must be set for each cluster.
+     --all-contexts                      Registers a cluster for each context in the kubeconfig but the
+                                         current one, which must point to the management cluster.
+                                         Each SveltosCluster is named after its context.
+     --kubeconfig=<file>                 (Optional) Kubeconfig containing the contexts. If not specified,
+                                         the default kubeconfig is used.
+     --namespace=<name>                  (Optional) Only with --all-contexts. Namespace of the SveltosClusters.
+                                         If not specified, default is used.
+     --labels=<key1=value1,key2=value2>  (Optional) Only with --all-contexts. Labels set on each SveltosCluster.
+     --service-account-token             (Optional) Only with --all-contexts. Use a non-expiring ServiceAccount
+                                         token instead of a renewed TokenRequest.
+     --concurrency=<value>               (Optional) Number of clusters registered in parallel. Default: 5.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The register clusters command registers many clusters at once and displays, for each cluster,
+  whether it was created, updated, left unchanged or failed to register.
+  The command can be safely rerun: existing SveltosClusters and Secrets are only updated when they
+  differ. For clusters registered from a context, a new kubeconfig is generated only when the
+  cluster is not registered yet.
+  Pull mode clusters must be registered one at a time with the register cluster command.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	kubeconfigFile := ""
+	if passedKubeconfig := parsedArgs["--kubeconfig"]; passedKubeconfig != nil {
+		kubeconfigFile = passedKubeconfig.(string)
+	}
+
+	concurrency := defaultConcurrency
+	if passedConcurrency := parsedArgs["--concurrency"]; passedConcurrency != nil {
+		concurrency, err = strconv.Atoi(passedConcurrency.(string))
+		if err != nil || concurrency <= 0 {
+			return errors.New("concurrency must be a positive integer")
+		}
+	}
+
+	var registrations []clusterRegistration
+	if fromFile := parsedArgs["--from-file"]; fromFile != nil {
+		if parsedArgs["--namespace"] != nil || parsedArgs["--labels"] != nil ||
+			parsedArgs["--service-account-token"].(bool) {
+
+			return errors.New("namespace, labels and service-account-token can only be used with all-contexts")
+		}
+		registrations, err = loadClusterRegistrations(fromFile.(string))
+	} else {
+		namespace := defaultClusterNamespace
+		if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+			namespace = passedNamespace.(string)
+		}
+		var labels map[string]string
+		if passedLabels := parsedArgs["--labels"]; passedLabels != nil {
+			labels, err = stringToMap(passedLabels.(string))
+			if err != nil {
+				return err
+			}
+		}
+		registrations, err = getAllContextRegistrations(kubeconfigFile, namespace, labels,
+			parsedArgs["--service-account-token"].(bool))
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(registrations) == 0 {
+		return errors.New("no cluster to register")
+	}
+
+	return registerClusters(ctx, registrations, kubeconfigFile, concurrency, os.Stdout, logger)
+}
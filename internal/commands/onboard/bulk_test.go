@@ -0,0 +1,172 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboard_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/onboard"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	multiContextKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: mgmt
+  cluster:
+    server: https://mgmt:6443
+- name: prod
+  cluster:
+    server: https://prod:6443
+contexts:
+- name: mgmt
+  context:
+    cluster: mgmt
+    user: admin
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+- name: staging
+  context:
+    cluster: prod
+    user: admin
+current-context: mgmt
+users:
+- name: admin
+  user:
+    token: token
+`
+)
+
+var _ = Describe("Register clusters", func() {
+	var folder string
+
+	BeforeEach(func() {
+		folder = GinkgoT().TempDir()
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	})
+
+	writeFile := func(name, content string) string {
+		fileName := filepath.Join(folder, name)
+		Expect(os.WriteFile(fileName, []byte(content), 0o600)).To(Succeed())
+		return fileName
+	}
+
+	It("loadClusterRegistrations validates the clusters listed in the file", func() {
+		fileName := writeFile("clusters.yaml", `clusters:
+- name: prod
+  kubeconfig: prod.kubeconfig
+  labels:
+    env: prod
+- namespace: eng
+  name: staging
+  context: staging
+`)
+		registrations, err := onboard.LoadClusterRegistrations(fileName)
+		Expect(err).To(BeNil())
+		Expect(registrations).To(HaveLen(2))
+		Expect(registrations[0].Namespace).To(Equal("default"))
+		Expect(registrations[0].Labels).To(HaveKeyWithValue("env", "prod"))
+		Expect(registrations[1].Context).To(Equal("staging"))
+
+		fileName = writeFile("invalid.yaml", `clusters:
+- name: prod
+  kubeconfig: prod.kubeconfig
+  context: prod
+`)
+		_, err = onboard.LoadClusterRegistrations(fileName)
+		Expect(err).ToNot(BeNil())
+
+		fileName = writeFile("duplicated.yaml", `clusters:
+- name: prod
+  kubeconfig: prod.kubeconfig
+- name: prod
+  kubeconfig: prod.kubeconfig
+`)
+		_, err = onboard.LoadClusterRegistrations(fileName)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("getAllContextRegistrations returns all contexts but the current one", func() {
+		fileName := writeFile("config", multiContextKubeconfig)
+
+		labels := map[string]string{"env": "fleet"}
+		registrations, err := onboard.GetAllContextRegistrations(fileName, "fleet", labels, false)
+		Expect(err).To(BeNil())
+		Expect(registrations).To(HaveLen(2))
+		Expect(registrations[0].Name).To(Equal("prod"))
+		Expect(registrations[0].Context).To(Equal("prod"))
+		Expect(registrations[0].Namespace).To(Equal("fleet"))
+		Expect(registrations[0].Labels).To(Equal(labels))
+		Expect(registrations[1].Name).To(Equal("staging"))
+	})
+
+	It("registerClusters registers clusters concurrently and is idempotent", func() {
+		const clusters = 4
+		content := "clusters:\n"
+		for i := 0; i < clusters; i++ {
+			kubeconfigFile := writeFile(fmt.Sprintf("cluster%d.kubeconfig", i), randomString())
+			content += fmt.Sprintf("- name: cluster%d\n  kubeconfig: %s\n  labels:\n    index: \"%d\"\n",
+				i, kubeconfigFile, i)
+		}
+		content += "- name: missing\n  kubeconfig: " + filepath.Join(folder, "missing.kubeconfig") + "\n"
+
+		registrations, err := onboard.LoadClusterRegistrations(writeFile("clusters.yaml", content))
+		Expect(err).To(BeNil())
+
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		var out bytes.Buffer
+		err = onboard.BulkRegisterClusters(context.TODO(), registrations, "", 2, &out, logger)
+		Expect(err).ToNot(BeNil())
+		Expect(regexp.MustCompile(`default/cluster\d+ .*│ created`).FindAllString(out.String(), -1)).To(HaveLen(clusters))
+		Expect(out.String()).To(MatchRegexp(`default/missing .*│ failed`))
+
+		instance := utils.GetAccessInstance()
+		for i := 0; i < clusters; i++ {
+			sveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+			Expect(instance.GetResource(context.TODO(),
+				types.NamespacedName{Namespace: "default", Name: fmt.Sprintf("cluster%d", i)}, sveltosCluster)).To(Succeed())
+			Expect(sveltosCluster.Labels).To(HaveKeyWithValue("index", fmt.Sprint(i)))
+		}
+
+		// Rerun only changes what differs
+		registrations = registrations[:clusters]
+		registrations[0].Labels = map[string]string{"index": "changed"}
+		out.Reset()
+		Expect(onboard.BulkRegisterClusters(context.TODO(), registrations, "", 2, &out, logger)).To(Succeed())
+		Expect(out.String()).To(MatchRegexp(`default/cluster0 .*│ updated`))
+		Expect(regexp.MustCompile(`default/cluster\d+ .*│ unchanged`).FindAllString(out.String(), -1)).To(HaveLen(clusters - 1))
+	})
+})
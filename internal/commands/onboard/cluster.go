@@ -17,9 +17,11 @@ limitations under the License.
 package onboard
 
 import (
+	"bytes"
 	"context"
 	"flag"
 	"fmt"
+	"maps"
 	"os"
 	"strings"
 	"time"
@@ -33,6 +35,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
 	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
@@ -49,33 +52,40 @@ const (
 func onboardSveltosCluster(ctx context.Context, clusterNamespace, clusterName string, kubeconfigData []byte,
 	labels map[string]string, renew bool, logger logr.Logger) error {
 
-	instance := utils.GetAccessInstance()
-
-	secretName := clusterName + sveltosKubeconfigSecretNamePostfix
-	logger.V(logs.LogDebug).Info(fmt.Sprintf("Verifying Secret %s/%s does not exist already", clusterNamespace, secretName))
-	secret := &corev1.Secret{}
-	err := instance.GetResource(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: secretName}, secret)
-	if err != nil && !apierrors.IsNotFound(err) {
+	_, err := registerSveltosCluster(ctx, clusterNamespace, clusterName, kubeconfigData, labels, renew, logger)
+	if err != nil {
 		return err
 	}
 
-	err = patchSecret(ctx, clusterNamespace, secretName, kubeconfigData, logger)
+	//nolint: forbidigo // print success message
+	fmt.Printf("cluster %s successfully registered/updated in namespace %s.", clusterName, clusterNamespace)
+	return nil
+}
+
+// registerSveltosCluster creates or updates the kubeconfig Secret and the SveltosCluster.
+// Returned result is OperationResultNone only if neither resource had to be changed.
+func registerSveltosCluster(ctx context.Context, clusterNamespace, clusterName string, kubeconfigData []byte,
+	labels map[string]string, renew bool, logger logr.Logger) (controllerutil.OperationResult, error) {
+
+	secretName := clusterName + sveltosKubeconfigSecretNamePostfix
+	secretResult, err := patchSecret(ctx, clusterNamespace, secretName, kubeconfigData, logger)
 	if err != nil {
-		return err
+		return controllerutil.OperationResultNone, err
 	}
 
-	err = patchSveltosCluster(ctx, clusterNamespace, clusterName, labels, renew, logger)
+	clusterResult, err := patchSveltosCluster(ctx, clusterNamespace, clusterName, labels, renew, logger)
 	if err != nil {
-		return err
+		return controllerutil.OperationResultNone, err
 	}
 
-	//nolint: forbidigo // print success message
-	fmt.Printf("cluster %s successfully registered/updated in namespace %s.", clusterName, clusterNamespace)
-	return nil
+	if clusterResult != controllerutil.OperationResultNone {
+		return clusterResult, nil
+	}
+	return secretResult, nil
 }
 
 func patchSveltosCluster(ctx context.Context, clusterNamespace, clusterName string,
-	labels map[string]string, renew bool, logger logr.Logger) error {
+	labels map[string]string, renew bool, logger logr.Logger) (controllerutil.OperationResult, error) {
 
 	instance := utils.GetAccessInstance()
 
@@ -96,18 +106,27 @@ func patchSveltosCluster(ctx context.Context, clusterNamespace, clusterName stri
 				}
 			}
 
-			return instance.CreateResource(ctx, currentSveltosCluster)
+			return controllerutil.OperationResultCreated, instance.CreateResource(ctx, currentSveltosCluster)
 		}
-		return err
+		return controllerutil.OperationResultNone, err
+	}
+
+	if maps.Equal(currentSveltosCluster.Labels, labels) &&
+		currentSveltosCluster.Spec.KubeconfigKeyName == kubeconfig {
+
+		logger.V(logs.LogDebug).Info("SveltosCluster is already up to date")
+		return controllerutil.OperationResultNone, nil
 	}
 
 	logger.V(logs.LogDebug).Info("Updating SveltosCluster")
 	currentSveltosCluster.Labels = labels
 	currentSveltosCluster.Spec.KubeconfigKeyName = kubeconfig
-	return instance.UpdateResource(ctx, currentSveltosCluster)
+	return controllerutil.OperationResultUpdated, instance.UpdateResource(ctx, currentSveltosCluster)
 }
 
-func patchSecret(ctx context.Context, clusterNamespace, secretName string, kubeconfigData []byte, logger logr.Logger) error {
+func patchSecret(ctx context.Context, clusterNamespace, secretName string, kubeconfigData []byte,
+	logger logr.Logger) (controllerutil.OperationResult, error) {
+
 	instance := utils.GetAccessInstance()
 
 	currentSecret := &corev1.Secret{}
@@ -118,9 +137,14 @@ func patchSecret(ctx context.Context, clusterNamespace, secretName string, kubec
 			currentSecret.Namespace = clusterNamespace
 			currentSecret.Name = secretName
 			currentSecret.Data = map[string][]byte{kubeconfig: kubeconfigData}
-			return instance.CreateResource(ctx, currentSecret)
+			return controllerutil.OperationResultCreated, instance.CreateResource(ctx, currentSecret)
 		}
-		return err
+		return controllerutil.OperationResultNone, err
+	}
+
+	if len(currentSecret.Data) == 1 && bytes.Equal(currentSecret.Data[kubeconfig], kubeconfigData) {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Secret %s/%s is already up to date", clusterNamespace, secretName))
+		return controllerutil.OperationResultNone, nil
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Updating Secret %s/%s", clusterNamespace, secretName))
@@ -128,14 +152,14 @@ func patchSecret(ctx context.Context, clusterNamespace, secretName string, kubec
 		kubeconfig: kubeconfigData,
 	}
 
-	return instance.UpdateResource(ctx, currentSecret)
+	return controllerutil.OperationResultUpdated, instance.UpdateResource(ctx, currentSecret)
 }
 
 // RegisterCluster takes care of creating all necessary internal resources to import a cluster
 func RegisterCluster(ctx context.Context, args []string, logger logr.Logger) error { //nolint: funlen // command description
 	doc := `Usage:
   sveltosctl register cluster [options] --namespace=<name> --cluster=<name> [--kubeconfig=<file>] [--fleet-cluster-context=<value>] [--pullmode]
-                                [--labels=<value>] [--service-account-token] [--verbose]
+                                [--applier-values=<file>] [--apply] [--timeout=<duration>] [--labels=<value>] [--service-account-token] [--verbose]
 
      --namespace=<name>                  Specifies the namespace where Sveltos will create a resource (SveltosCluster) to represent
                                          the registered cluster.
@@ -160,6 +184,16 @@ func RegisterCluster(ctx context.Context, args []string, logger logr.Logger) err
                                          firewall restrictions or when direct inbound access to the managed cluster is undesirable.
                                          This flag outputs the specialized YAML configuration that needs to be applied to the managed
                                          cluster to complete its setup.
+     --applier-values=<file>             (Optional) Only valid with --pullmode. Path to a YAML file customizing the sveltos-applier
+                                         deployed in the managed cluster: image registry, repository, tag or digest, resources,
+                                         nodeSelector, tolerations, proxy settings, extra CA certificates and the rules of the
+                                         sveltos-applier ClusterRole (by default all permissions are granted).
+     --apply                             (Optional) Only valid with --pullmode. Instead of printing it, server-side applies the YAML
+                                         to the managed cluster identified by --kubeconfig and/or --fleet-cluster-context, then
+                                         waits for the sveltos-applier Deployment to be ready and for the SveltosCluster to be
+                                         Ready in the management cluster.
+     --timeout=<duration>                (Optional) Only valid with --apply. How long to wait for sveltos-applier and the
+                                         SveltosCluster to be ready. Default: 5m.
      --labels=<key1=value1,key2=value2>  (Optional) This option allows you to specify labels for the SveltosCluster resource
                                          being created. The format for labels is <key1=value1,key2=value2>, where each key-value
                                          pair is separated by a comma (,) and the key and value are separated by an equal sign (=).
@@ -239,8 +273,36 @@ Description:
 		fleetClusterContext = passedContext.(string)
 	}
 
+	var values *applierValues
+	if passedValues := parsedArgs["--applier-values"]; passedValues != nil {
+		if !pullMode {
+			return fmt.Errorf("applier-values can only be used with pullmode")
+		}
+		values, err = loadApplierValues(passedValues.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	apply := parsedArgs["--apply"].(bool)
+	if apply && !pullMode {
+		return fmt.Errorf("apply can only be used with pullmode")
+	}
+
+	timeout := defaultApplyTimeout
+	if passedTimeout := parsedArgs["--timeout"]; passedTimeout != nil {
+		if !apply {
+			return fmt.Errorf("timeout can only be used with apply")
+		}
+		timeout, err = time.ParseDuration(passedTimeout.(string))
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid timeout %q: must be a positive duration", passedTimeout.(string))
+		}
+	}
+
 	if pullMode {
-		return onboardSveltosClusterInPullMode(ctx, namespace, cluster, labels, logger)
+		return registerClusterInPullMode(ctx, namespace, cluster, labels, values, apply,
+			kubeconfig, fleetClusterContext, timeout, logger)
 	}
 
 	if kubeconfig == "" && fleetClusterContext == "" {
@@ -255,6 +317,38 @@ Description:
 	return onboardSveltosCluster(ctx, namespace, cluster, data, labels, renew, logger)
 }
 
+// registerClusterInPullMode registers a cluster in pull mode. The sveltos-applier YAML is either
+// printed or, if apply is set, deployed in the managed cluster.
+func registerClusterInPullMode(ctx context.Context, namespace, cluster string, labels map[string]string,
+	values *applierValues, apply bool, kubeconfigFile, fleetClusterContext string, timeout time.Duration,
+	logger logr.Logger) error {
+
+	var restConfig *rest.Config
+	if apply {
+		if kubeconfigFile == "" && fleetClusterContext == "" {
+			return fmt.Errorf("either kubeconfig or fleet-cluster-context must be specified with apply")
+		}
+		var err error
+		restConfig, err = getClientConfig(kubeconfigFile, fleetClusterContext).ClientConfig()
+		if err != nil {
+			return fmt.Errorf("failed to get managed cluster config: %w", err)
+		}
+	}
+
+	toApplyYAML, err := onboardSveltosClusterInPullMode(ctx, namespace, cluster, labels, values, logger)
+	if err != nil {
+		return err
+	}
+
+	if !apply {
+		//nolint: forbidigo // this is printing the YAML to apply to managed cluster
+		fmt.Printf("%s", toApplyYAML)
+		return nil
+	}
+
+	return deployApplier(ctx, restConfig, namespace, cluster, toApplyYAML, timeout, os.Stdout, logger)
+}
+
 func getKubeconfigData(ctx context.Context, kubeconfigFile, fleetClusterContext string,
 	satoken bool, logger logr.Logger) ([]byte, error) {
 
@@ -20,7 +20,9 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/docopt/docopt-go"
 	"github.com/go-logr/logr"
@@ -38,14 +40,37 @@ import (
 // DeregisterCluster takes care of removing all resources created during cluster registration
 func DeregisterCluster(ctx context.Context, args []string, logger logr.Logger) error {
 	doc := `Usage:
-  sveltosctl deregister cluster [options] --namespace=<name> --cluster=<name> [--verbose]
-
-     --namespace=<name>     Specifies the namespace where the SveltosCluster resource is located.
-     --cluster=<name>       Defines the name of the registered cluster to remove.
+  sveltosctl deregister cluster [options] --namespace=<name> --cluster=<name> [--cleanup-managed] [--withdraw]
+                                  [--kubeconfig=<file>] [--fleet-cluster-context=<value>] [--timeout=<duration>]
+                                  [--dry-run=<mode>] [--yes] [--verbose]
+
+     --namespace=<name>                Specifies the namespace where the SveltosCluster resource is located.
+     --cluster=<name>                  Defines the name of the registered cluster to remove.
+     --cleanup-managed                 (Optional) Also clean up the managed cluster. Add-ons deployed by Sveltos (as
+                                       reported by the ClusterConfiguration) are listed, then the projectsveltos namespace,
+                                       where Sveltos agents run, and the cluster wide resources of sveltos-applier,
+                                       sveltos-agent and drift-detection-manager (ClusterRoles, ClusterRoleBindings and
+                                       Sveltos CRDs) are removed from the managed cluster. The management cluster is refused.
+     --withdraw                        (Optional) Only valid with --cleanup-managed. Before cleaning up, withdraw the add-ons
+                                       deployed by Sveltos: cluster labels are removed and ClusterSummaries for the cluster are
+                                       deleted, then the command waits for the addon-controller to remove the add-ons.
+                                       If add-ons cannot be withdrawn, cluster labels are restored. Clusters referenced
+                                       by a ClusterProfile/Profile ClusterRefs or SetRefs are refused.
+     --kubeconfig=<file>               (Optional) Only valid with --cleanup-managed. Kubeconfig to access the managed cluster.
+                                       Required, with or instead of --fleet-cluster-context, for clusters in pull mode.
+                                       Otherwise the kubeconfig stored in the management cluster is used.
+     --fleet-cluster-context=<value>   (Optional) Only valid with --cleanup-managed. Kubeconfig context to access the managed
+                                       cluster.
+     --timeout=<duration>              (Optional) Only valid with --withdraw. How long to wait for add-ons to be withdrawn.
+                                       Default: 5m.
+     --dry-run=<mode>                  (Optional) Print the objects to delete without deleting them. Accepted values:
+                                       client (only print), server (also submit the deletions to the API server in
+                                       dry-run mode) and none.
+     --yes                             (Optional) Delete without asking for confirmation.
 
 Options:
-  -h --help                Show this screen.
-     --verbose             Verbose mode. Print each step.
+  -h --help                          Show this screen.
+     --verbose                       Verbose mode. Print each step.
 
 Description:
   The deregister cluster command removes a cluster that was previously registered with Sveltos.
@@ -53,6 +78,7 @@ Description:
   - The SveltosCluster resource
   - The associated kubeconfig Secret
   - For pull-mode clusters: ServiceAccount, Roles, RoleBindings, and ClusterRole/ClusterRoleBinding
+  With --cleanup-managed, Sveltos agents are also removed from the managed cluster.
 `
 	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
 	if err != nil {
@@ -90,11 +116,67 @@ Description:
 		return fmt.Errorf("both --namespace and --cluster must be specified")
 	}
 
-	return deregisterSveltosCluster(ctx, namespace, cluster, logger)
+	dryRun, err := utils.ParseDryRun(parsedArgs["--dry-run"])
+	if err != nil {
+		return err
+	}
+	yes := parsedArgs["--yes"].(bool)
+
+	cleanupManaged := parsedArgs["--cleanup-managed"].(bool)
+	if !cleanupManaged {
+		for _, option := range []string{"--withdraw", "--kubeconfig", "--fleet-cluster-context", "--timeout"} {
+			if v := parsedArgs[option]; v != nil && v != false {
+				return fmt.Errorf("%s can only be used with --cleanup-managed", option)
+			}
+		}
+		changes, err := getDeregistrationChanges(ctx, utils.GetAccessInstance().GetClient(), namespace, cluster)
+		if err != nil {
+			return err
+		}
+		apply, err := utils.ConfirmChanges(os.Stdin, os.Stdout, changes, dryRun, yes)
+		if err != nil || !apply {
+			return err
+		}
+		return deregisterSveltosCluster(ctx, namespace, cluster, logger, getDeleteOptions(dryRun)...)
+	}
+
+	options := &cleanupOptions{
+		withdraw: parsedArgs["--withdraw"].(bool),
+		timeout:  defaultWithdrawTimeout,
+		dryRun:   dryRun,
+		yes:      yes,
+	}
+
+	if passedTimeout := parsedArgs["--timeout"]; passedTimeout != nil {
+		if !options.withdraw {
+			return fmt.Errorf("--timeout can only be used with --withdraw")
+		}
+		options.timeout, err = time.ParseDuration(passedTimeout.(string))
+		if err != nil || options.timeout <= 0 {
+			return fmt.Errorf("invalid timeout %q: must be a positive duration", passedTimeout.(string))
+		}
+	}
+
+	kubeconfigFile := ""
+	if passedKubeconfig := parsedArgs["--kubeconfig"]; passedKubeconfig != nil {
+		kubeconfigFile = passedKubeconfig.(string)
+	}
+	fleetClusterContext := ""
+	if passedContext := parsedArgs["--fleet-cluster-context"]; passedContext != nil {
+		fleetClusterContext = passedContext.(string)
+	}
+	if kubeconfigFile != "" || fleetClusterContext != "" {
+		options.managedConfig, err = getClientConfig(kubeconfigFile, fleetClusterContext).ClientConfig()
+		if err != nil {
+			return fmt.Errorf("failed to get managed cluster config: %w", err)
+		}
+	}
+
+	return deregisterSveltosClusterWithCleanup(ctx, namespace, cluster, options, os.Stdin, os.Stdout, logger)
 }
 
 func deregisterSveltosCluster(ctx context.Context, clusterNamespace, clusterName string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) error {
 
 	instance := utils.GetAccessInstance()
@@ -115,7 +197,7 @@ func deregisterSveltosCluster(ctx context.Context, clusterNamespace, clusterName
 			// Even if the SveltosCluster is gone, try to clean up the kubeconfig secret
 			deletedResources := []string{}
 			secretName := clusterName + sveltosKubeconfigSecretNamePostfix
-			if err := deleteSecret(ctx, c, clusterNamespace, secretName, logger); err != nil {
+			if err := deleteSecret(ctx, c, clusterNamespace, secretName, logger, opts...); err != nil {
 				logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete kubeconfig Secret: %v", err))
 			} else {
 				deletedResources = append(deletedResources,
@@ -147,22 +229,31 @@ func deregisterSveltosCluster(ctx context.Context, clusterNamespace, clusterName
 
 	// Delete pull-mode specific resources
 	if isPullMode {
-		pullModeResources := deletePullModeResources(ctx, c, clusterNamespace, clusterName, logger)
+		pullModeResources := deletePullModeResources(ctx, c, clusterNamespace, clusterName, logger, opts...)
 		deletedResources = append(deletedResources, pullModeResources...)
 	}
 
 	// Delete common resources (kubeconfig secret)
-	secretResources := deletePushModeResources(ctx, c, clusterNamespace, clusterName, logger)
+	secretResources := deletePushModeResources(ctx, c, clusterNamespace, clusterName, logger, opts...)
 	deletedResources = append(deletedResources, secretResources...)
 
 	// Delete SveltosCluster
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Deleting SveltosCluster %s/%s", clusterNamespace, clusterName))
-	if err := instance.DeleteResource(ctx, sveltosCluster); err != nil {
+	if err := instance.DeleteResource(ctx, sveltosCluster, opts...); err != nil {
 		return fmt.Errorf("failed to delete SveltosCluster: %w", err)
 	}
 	deletedResources = append(deletedResources,
 		fmt.Sprintf("SveltosCluster/%s/%s", clusterNamespace, clusterName))
 
+	deleteOptions := &client.DeleteOptions{}
+	deleteOptions.ApplyOptions(opts)
+	if len(deleteOptions.DryRun) != 0 {
+		//nolint: forbidigo // print dry run message
+		fmt.Printf("Dry run (server): deregistration of cluster %s/%s validated, no changes made\n",
+			clusterNamespace, clusterName)
+		return nil
+	}
+
 	//nolint: forbidigo // print success message
 	fmt.Printf("Successfully deregistered cluster %s/%s\n", clusterNamespace, clusterName)
 	//nolint: forbidigo // print deleted resources
@@ -175,16 +266,86 @@ func deregisterSveltosCluster(ctx context.Context, clusterNamespace, clusterName
 	return nil
 }
 
+// describeObject returns kind/namespace/name, or kind/name for cluster wide objects
+func describeObject(kind, namespace, name string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s/%s", kind, name)
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+// getDeleteOptions returns the options to delete objects with in the given dry-run mode
+func getDeleteOptions(dryRun utils.DryRunMode) []client.DeleteOption {
+	if dryRun == utils.DryRunServer {
+		return []client.DeleteOption{client.DryRunAll}
+	}
+	return nil
+}
+
+// getDeregistrationChanges returns the deletions, in the management cluster, deregistering
+// the cluster requires. Only existing objects are reported.
+func getDeregistrationChanges(ctx context.Context, c client.Client, clusterNamespace, clusterName string,
+) ([]utils.Change, error) {
+
+	sveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: clusterName}, sveltosCluster)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get SveltosCluster: %w", err)
+	}
+
+	type candidate struct {
+		kind   string
+		key    types.NamespacedName
+		object client.Object
+	}
+	namespacedKey := types.NamespacedName{Namespace: clusterNamespace, Name: clusterName}
+	clusterWideKey := types.NamespacedName{Name: clusterNamespace + "-" + clusterName}
+
+	candidates := []candidate{}
+	if err == nil && sveltosCluster.Spec.PullMode {
+		candidates = append(candidates,
+			candidate{"ClusterRoleBinding", clusterWideKey, &rbacv1.ClusterRoleBinding{}},
+			candidate{"ClusterRole", clusterWideKey, &rbacv1.ClusterRole{}},
+			candidate{"RoleBinding", namespacedKey, &rbacv1.RoleBinding{}},
+			candidate{"Role", namespacedKey, &rbacv1.Role{}},
+			candidate{"Secret", namespacedKey, &corev1.Secret{}},
+			candidate{"ServiceAccount", namespacedKey, &corev1.ServiceAccount{}},
+		)
+	}
+	candidates = append(candidates,
+		candidate{"Secret", types.NamespacedName{Namespace: clusterNamespace,
+			Name: clusterName + sveltosKubeconfigSecretNamePostfix}, &corev1.Secret{}},
+		candidate{"SveltosCluster", namespacedKey, &libsveltosv1beta1.SveltosCluster{}},
+	)
+
+	changes := []utils.Change{}
+	for i := range candidates {
+		err := c.Get(ctx, candidates[i].key, candidates[i].object)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		changes = append(changes, utils.Change{
+			Action: "delete",
+			Object: describeObject(candidates[i].kind, candidates[i].key.Namespace, candidates[i].key.Name),
+		})
+	}
+
+	return changes, nil
+}
+
 // deletePullModeResources removes all pull-mode specific resources
 // Returns a list of successfully deleted resources
 func deletePullModeResources(ctx context.Context, c client.Client, clusterNamespace, clusterName string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) []string {
 
 	deletedResources := []string{}
 
 	// Delete ClusterRoleBinding
-	if err := deleteClusterRoleBinding(ctx, c, clusterNamespace, clusterName, logger); err != nil {
+	if err := deleteClusterRoleBinding(ctx, c, clusterNamespace, clusterName, logger, opts...); err != nil {
 		logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete ClusterRoleBinding: %v", err))
 	} else {
 		deletedResources = append(deletedResources,
@@ -192,7 +353,7 @@ func deletePullModeResources(ctx context.Context, c client.Client, clusterNamesp
 	}
 
 	// Delete ClusterRole
-	if err := deleteClusterRole(ctx, c, clusterNamespace, clusterName, logger); err != nil {
+	if err := deleteClusterRole(ctx, c, clusterNamespace, clusterName, logger, opts...); err != nil {
 		logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete ClusterRole: %v", err))
 	} else {
 		deletedResources = append(deletedResources,
@@ -200,7 +361,7 @@ func deletePullModeResources(ctx context.Context, c client.Client, clusterNamesp
 	}
 
 	// Delete RoleBinding
-	if err := deleteRoleBinding(ctx, c, clusterNamespace, clusterName, logger); err != nil {
+	if err := deleteRoleBinding(ctx, c, clusterNamespace, clusterName, logger, opts...); err != nil {
 		logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete RoleBinding: %v", err))
 	} else {
 		deletedResources = append(deletedResources,
@@ -208,7 +369,7 @@ func deletePullModeResources(ctx context.Context, c client.Client, clusterNamesp
 	}
 
 	// Delete Role
-	if err := deleteRole(ctx, c, clusterNamespace, clusterName, logger); err != nil {
+	if err := deleteRole(ctx, c, clusterNamespace, clusterName, logger, opts...); err != nil {
 		logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete Role: %v", err))
 	} else {
 		deletedResources = append(deletedResources,
@@ -216,7 +377,7 @@ func deletePullModeResources(ctx context.Context, c client.Client, clusterNamesp
 	}
 
 	// Delete ServiceAccount Secret
-	if err := deleteSecret(ctx, c, clusterNamespace, clusterName, logger); err != nil {
+	if err := deleteSecret(ctx, c, clusterNamespace, clusterName, logger, opts...); err != nil {
 		logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete ServiceAccount Secret: %v", err))
 	} else {
 		deletedResources = append(deletedResources,
@@ -224,7 +385,7 @@ func deletePullModeResources(ctx context.Context, c client.Client, clusterNamesp
 	}
 
 	// Delete ServiceAccount
-	if err := deleteServiceAccount(ctx, c, clusterNamespace, clusterName, logger); err != nil {
+	if err := deleteServiceAccount(ctx, c, clusterNamespace, clusterName, logger, opts...); err != nil {
 		logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete ServiceAccount: %v", err))
 	} else {
 		deletedResources = append(deletedResources,
@@ -238,14 +399,14 @@ func deletePullModeResources(ctx context.Context, c client.Client, clusterNamesp
 // This is common to both push and pull mode clusters
 // Returns a list of successfully deleted resources
 func deletePushModeResources(ctx context.Context, c client.Client, clusterNamespace, clusterName string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) []string {
 
 	deletedResources := []string{}
 
 	// Delete kubeconfig Secret
 	secretName := clusterName + sveltosKubeconfigSecretNamePostfix
-	if err := deleteSecret(ctx, c, clusterNamespace, secretName, logger); err != nil {
+	if err := deleteSecret(ctx, c, clusterNamespace, secretName, logger, opts...); err != nil {
 		logger.V(logs.LogInfo).Info(fmt.Sprintf("Warning: failed to delete kubeconfig Secret: %v", err))
 	} else {
 		deletedResources = append(deletedResources,
@@ -256,7 +417,7 @@ func deletePushModeResources(ctx context.Context, c client.Client, clusterNamesp
 }
 
 func deleteServiceAccount(ctx context.Context, c client.Client, namespace, name string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) error {
 
 	serviceAccount := &corev1.ServiceAccount{}
@@ -270,11 +431,11 @@ func deleteServiceAccount(ctx context.Context, c client.Client, namespace, name
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Deleting ServiceAccount %s/%s", namespace, name))
-	return c.Delete(ctx, serviceAccount)
+	return c.Delete(ctx, serviceAccount, opts...)
 }
 
 func deleteSecret(ctx context.Context, c client.Client, namespace, name string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) error {
 
 	secret := &corev1.Secret{}
@@ -288,11 +449,11 @@ func deleteSecret(ctx context.Context, c client.Client, namespace, name string,
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Deleting Secret %s/%s", namespace, name))
-	return c.Delete(ctx, secret)
+	return c.Delete(ctx, secret, opts...)
 }
 
 func deleteRole(ctx context.Context, c client.Client, namespace, name string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) error {
 
 	role := &rbacv1.Role{}
@@ -306,11 +467,11 @@ func deleteRole(ctx context.Context, c client.Client, namespace, name string,
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Deleting Role %s/%s", namespace, name))
-	return c.Delete(ctx, role)
+	return c.Delete(ctx, role, opts...)
 }
 
 func deleteClusterRole(ctx context.Context, c client.Client, namespace, name string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) error {
 
 	clusterRoleName := namespace + "-" + name
@@ -325,11 +486,11 @@ func deleteClusterRole(ctx context.Context, c client.Client, namespace, name str
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Deleting ClusterRole %s", clusterRoleName))
-	return c.Delete(ctx, clusterRole)
+	return c.Delete(ctx, clusterRole, opts...)
 }
 
 func deleteRoleBinding(ctx context.Context, c client.Client, namespace, name string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) error {
 
 	roleBinding := &rbacv1.RoleBinding{}
@@ -343,11 +504,11 @@ func deleteRoleBinding(ctx context.Context, c client.Client, namespace, name str
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Deleting RoleBinding %s/%s", namespace, name))
-	return c.Delete(ctx, roleBinding)
+	return c.Delete(ctx, roleBinding, opts...)
 }
 
 func deleteClusterRoleBinding(ctx context.Context, c client.Client, namespace, name string,
-	logger logr.Logger,
+	logger logr.Logger, opts ...client.DeleteOption,
 ) error {
 
 	clusterRoleBindingName := namespace + "-" + name
@@ -362,5 +523,5 @@ func deleteClusterRoleBinding(ctx context.Context, c client.Client, namespace, n
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Deleting ClusterRoleBinding %s", clusterRoleBindingName))
-	return c.Delete(ctx, clusterRoleBinding)
+	return c.Delete(ctx, clusterRoleBinding, opts...)
 }
@@ -0,0 +1,610 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	"github.com/projectsveltos/libsveltos/lib/crd"
+	"github.com/projectsveltos/libsveltos/lib/deployer"
+	"github.com/projectsveltos/libsveltos/lib/k8s_utils"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/agent"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	defaultWithdrawTimeout = 5 * time.Minute
+	kubeSystemNamespace    = "kube-system"
+)
+
+var (
+	// ClusterRoles and ClusterRoleBindings created in the managed cluster, in push mode, for
+	// sveltos-agent and drift-detection-manager
+	agentClusterRoles        = []string{"sveltos-agent-manager-role", "drift-detection-manager-role"}
+	agentClusterRoleBindings = []string{"sveltos-agent-manager-rolebinding", "drift-detection-manager-rolebinding"}
+)
+
+// cleanupOptions contains the options of deregister cluster --cleanup-managed
+type cleanupOptions struct {
+	// withdraw, if set, removes add-ons deployed by Sveltos before deregistering the cluster
+	withdraw bool
+	// timeout is how long to wait for add-ons to be withdrawn
+	timeout time.Duration
+	// managedConfig is used to access the managed cluster. If nil, the kubeconfig stored
+	// in the management cluster is used (not available for clusters in pull mode)
+	managedConfig *rest.Config
+	// dryRun controls whether changes are only printed, validated by the API servers or applied
+	dryRun utils.DryRunMode
+	// yes, if set, applies changes without asking for confirmation
+	yes bool
+}
+
+// deregisterSveltosClusterWithCleanup shows the add-ons Sveltos deployed in the managed cluster,
+// optionally withdraws them, removes Sveltos agents from the managed cluster and finally
+// deregisters the cluster.
+func deregisterSveltosClusterWithCleanup(ctx context.Context, clusterNamespace, clusterName string,
+	options *cleanupOptions, in io.Reader, out io.Writer, logger logr.Logger) error {
+
+	instance := utils.GetAccessInstance()
+
+	sveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+	err := instance.GetResource(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: clusterName},
+		sveltosCluster)
+	if err != nil {
+		return fmt.Errorf("failed to get SveltosCluster %s/%s: %w", clusterNamespace, clusterName, err)
+	}
+
+	// Access to the managed cluster must be verified before anything is changed
+	managedConfig := options.managedConfig
+	if managedConfig == nil {
+		if sveltosCluster.Spec.PullMode {
+			return fmt.Errorf("cluster %s/%s is in pull mode: kubeconfig or fleet-cluster-context must be specified",
+				clusterNamespace, clusterName)
+		}
+		managedConfig, err = clusterproxy.GetKubernetesRestConfig(ctx, instance.GetClient(),
+			clusterNamespace, clusterName, "", "", libsveltosv1beta1.ClusterTypeSveltos, logger)
+		if err != nil {
+			return fmt.Errorf("failed to get managed cluster kubeconfig: %w", err)
+		}
+	}
+	managedClient, err := getManagedClient(managedConfig)
+	if err != nil {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("failed to get managed cluster client: %v", err))
+		return err
+	}
+
+	if err := verifyNotManagementCluster(ctx, instance.GetClient(), managedClient); err != nil {
+		return err
+	}
+
+	if err := printDeployedAddOns(ctx, clusterNamespace, clusterName, out, logger); err != nil {
+		return err
+	}
+
+	changes := []utils.Change{}
+	if options.withdraw {
+		withdrawChanges, err := getWithdrawChanges(ctx, sveltosCluster, logger)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, withdrawChanges...)
+	}
+
+	managedObjects, err := getManagedClusterObjects(ctx, managedClient)
+	if err != nil {
+		return err
+	}
+	for i := range managedObjects {
+		changes = append(changes, utils.Change{
+			Action:  "delete",
+			Object:  describeObject(managedObjects[i].GetKind(), managedObjects[i].GetNamespace(), managedObjects[i].GetName()),
+			Details: "managed cluster",
+		})
+	}
+
+	deregistrationChanges, err := getDeregistrationChanges(ctx, instance.GetClient(), clusterNamespace, clusterName)
+	if err != nil {
+		return err
+	}
+	changes = append(changes, deregistrationChanges...)
+
+	apply, err := utils.ConfirmChanges(in, out, changes, options.dryRun, options.yes)
+	if err != nil || !apply {
+		return err
+	}
+
+	serverDryRun := options.dryRun == utils.DryRunServer
+	if options.withdraw {
+		if err := withdrawAddOns(ctx, sveltosCluster, options.timeout, serverDryRun, out, logger); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(out, "Add-ons are left in the managed cluster. Use --withdraw to remove them.\n")
+	}
+
+	deleteOptions := getDeleteOptions(options.dryRun)
+	if err := deleteManagedClusterObjects(ctx, managedClient, managedObjects, logger, deleteOptions...); err != nil {
+		return err
+	}
+
+	return deregisterSveltosCluster(ctx, clusterNamespace, clusterName, logger, deleteOptions...)
+}
+
+// printDeployedAddOns prints helm charts and resources deployed by Sveltos in the cluster, as
+// reported by its ClusterConfiguration
+func printDeployedAddOns(ctx context.Context, clusterNamespace, clusterName string, out io.Writer,
+	logger logr.Logger) error {
+
+	instance := utils.GetAccessInstance()
+	clusterConfigurations, err := instance.ListClusterConfigurations(ctx, clusterNamespace, logger)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0)
+	for i := range clusterConfigurations.Items {
+		cc := &clusterConfigurations.Items[i]
+		if instance.GetClusterNameFromClusterConfiguration(cc) != clusterName ||
+			cc.Labels[configv1beta1.ClusterTypeLabel] == string(libsveltosv1beta1.ClusterTypeCapi) {
+
+			continue
+		}
+
+		charts := instance.GetHelmReleases(cc, logger)
+		for chart := range charts {
+			rows = append(rows, []string{"helm chart", chart.Namespace, chart.ReleaseName,
+				strings.Join(charts[chart], ", ")})
+		}
+		resources := instance.GetResources(cc, logger)
+		for resource := range resources {
+			rows = append(rows, []string{fmt.Sprintf("%s:%s", resource.Group, resource.Kind),
+				resource.Namespace, resource.Name, strings.Join(resources[resource], ", ")})
+		}
+	}
+
+	if len(rows) == 0 {
+		fmt.Fprintf(out, "No add-ons deployed by Sveltos in cluster %s/%s\n", clusterNamespace, clusterName)
+		return nil
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return strings.Join(rows[i], "/") < strings.Join(rows[j], "/")
+	})
+
+	fmt.Fprintf(out, "Add-ons deployed by Sveltos in cluster %s/%s:\n", clusterNamespace, clusterName)
+	table := tablewriter.NewWriter(out)
+	table.Header([]string{"TYPE", "NAMESPACE", "NAME", "PROFILES"})
+	for i := range rows {
+		if err := table.Append(rows[i]); err != nil {
+			return err
+		}
+	}
+	return table.Render()
+}
+
+// getWithdrawChanges returns the changes withdrawing add-ons from the cluster requires
+func getWithdrawChanges(ctx context.Context, sveltosCluster *libsveltosv1beta1.SveltosCluster,
+	logger logr.Logger) ([]utils.Change, error) {
+
+	// Labels are removed so that profiles stop matching the cluster through their ClusterSelector.
+	// Profiles matching it through ClusterRefs or SetRefs would recreate withdrawn add-ons.
+	profiles, err := getProfilesMatchingByRef(ctx, sveltosCluster, logger)
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) > 0 {
+		return nil, fmt.Errorf("cluster %s/%s is referenced by %s: add-ons cannot be withdrawn till "+
+			"the cluster is removed from their ClusterRefs/SetRefs",
+			sveltosCluster.Namespace, sveltosCluster.Name, strings.Join(profiles, ", "))
+	}
+
+	changes := []utils.Change{}
+	if len(sveltosCluster.Labels) != 0 {
+		changes = append(changes, utils.Change{
+			Action:  "update",
+			Object:  describeObject("SveltosCluster", sveltosCluster.Namespace, sveltosCluster.Name),
+			Details: "remove labels",
+		})
+	}
+
+	clusterSummaries := &configv1beta1.ClusterSummaryList{}
+	if err := utils.GetAccessInstance().GetClient().List(ctx, clusterSummaries,
+		getClusterSummaryListOptions(sveltosCluster)...); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterSummaries: %w", err)
+	}
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		changes = append(changes, utils.Change{
+			Action:  "delete",
+			Object:  describeObject("ClusterSummary", cs.Namespace, cs.Name),
+			Details: "withdraw add-ons",
+		})
+	}
+
+	return changes, nil
+}
+
+// withdrawAddOns removes all add-ons deployed by Sveltos in the cluster. Cluster labels are
+// removed so that no profile selects the cluster anymore, then any remaining ClusterSummary is
+// deleted. Deleting a ClusterSummary makes the addon-controller withdraw its add-ons from the
+// cluster. Returns once all ClusterSummaries for the cluster are gone. If add-ons cannot be
+// withdrawn, the original cluster labels are restored.
+// With serverDryRun changes are only validated by the API server and nothing is waited for.
+func withdrawAddOns(ctx context.Context, sveltosCluster *libsveltosv1beta1.SveltosCluster,
+	timeout time.Duration, serverDryRun bool, out io.Writer, logger logr.Logger) (err error) {
+
+	instance := utils.GetAccessInstance()
+	c := instance.GetClient()
+
+	updateOptions := []client.UpdateOption{}
+	deleteOptions := []client.DeleteOption{}
+	if serverDryRun {
+		updateOptions = append(updateOptions, client.DryRunAll)
+		deleteOptions = append(deleteOptions, client.DryRunAll)
+	}
+
+	if labels := sveltosCluster.Labels; len(labels) != 0 {
+		logger.V(logs.LogDebug).Info("removing SveltosCluster labels")
+		sveltosCluster.Labels = nil
+		if err := instance.UpdateResource(ctx, sveltosCluster, updateOptions...); err != nil {
+			return fmt.Errorf("failed to remove SveltosCluster labels: %w", err)
+		}
+		if !serverDryRun {
+			defer func() {
+				if err != nil {
+					// Context used to withdraw add-ons might be expired already
+					if restoreErr := restoreSveltosClusterLabels(context.Background(), sveltosCluster.Namespace,
+						sveltosCluster.Name, labels); restoreErr != nil {
+
+						err = fmt.Errorf("%w. Failed to restore SveltosCluster labels %v: %v", err, labels, restoreErr)
+					}
+				}
+			}()
+		}
+	}
+
+	listOptions := getClusterSummaryListOptions(sveltosCluster)
+	if serverDryRun {
+		_, err := deleteClusterSummaries(ctx, c, listOptions, logger, deleteOptions...)
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(applierPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pending, err := deleteClusterSummaries(ctx, c, listOptions, logger)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if err == nil && len(pending) == 0 {
+			fmt.Fprintf(out, "Add-ons withdrawn from cluster %s/%s\n", sveltosCluster.Namespace, sveltosCluster.Name)
+			return nil
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("waiting for ClusterSummaries %v to be removed", pending))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for add-ons to be withdrawn. Pending ClusterSummaries: %s",
+				strings.Join(pending, ", "))
+		case <-ticker.C:
+		}
+	}
+}
+
+// restoreSveltosClusterLabels sets back the labels removed from a SveltosCluster
+func restoreSveltosClusterLabels(ctx context.Context, namespace, name string, labels map[string]string) error {
+	c := utils.GetAccessInstance().GetClient()
+
+	sveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, sveltosCluster); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(sveltosCluster.DeepCopy())
+	sveltosCluster.Labels = labels
+	return c.Patch(ctx, sveltosCluster, patch)
+}
+
+// getProfilesMatchingByRef returns the ClusterProfiles/Profiles referencing the SveltosCluster either
+// in their ClusterRefs or through the ClusterRefs of a (Cluster)Set in their SetRefs
+func getProfilesMatchingByRef(ctx context.Context, sveltosCluster *libsveltosv1beta1.SveltosCluster,
+	logger logr.Logger) ([]string, error) {
+
+	instance := utils.GetAccessInstance()
+
+	result := make([]string, 0)
+
+	clusterProfiles, err := instance.ListClusterProfiles(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	for i := range clusterProfiles.Items {
+		cp := &clusterProfiles.Items[i]
+		referenced, err := isClusterReferenced(ctx, sveltosCluster, "", &cp.Spec)
+		if err != nil {
+			return nil, err
+		}
+		if referenced {
+			result = append(result, fmt.Sprintf("%s/%s", configv1beta1.ClusterProfileKind, cp.Name))
+		}
+	}
+
+	profiles, err := instance.ListProfiles(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	for i := range profiles.Items {
+		p := &profiles.Items[i]
+		// Profiles can only match clusters in their own namespace
+		if p.Namespace != sveltosCluster.Namespace {
+			continue
+		}
+		referenced, err := isClusterReferenced(ctx, sveltosCluster, p.Namespace, &p.Spec)
+		if err != nil {
+			return nil, err
+		}
+		if referenced {
+			result = append(result, fmt.Sprintf("%s/%s/%s", configv1beta1.ProfileKind, p.Namespace, p.Name))
+		}
+	}
+
+	return result, nil
+}
+
+// isClusterReferenced returns true if the profile spec references the SveltosCluster in its ClusterRefs
+// or in the ClusterRefs of a referenced set. Sets are ClusterSets for ClusterProfiles (empty namespace)
+// and Sets in the profile namespace for Profiles.
+func isClusterReferenced(ctx context.Context, sveltosCluster *libsveltosv1beta1.SveltosCluster,
+	namespace string, spec *configv1beta1.Spec) (bool, error) {
+
+	if containsSveltosCluster(spec.ClusterRefs, sveltosCluster) {
+		return true, nil
+	}
+
+	instance := utils.GetAccessInstance()
+	for _, setName := range spec.SetRefs {
+		var setSpec *libsveltosv1beta1.Spec
+		if namespace == "" {
+			clusterSet := &libsveltosv1beta1.ClusterSet{}
+			if err := instance.GetResource(ctx, types.NamespacedName{Name: setName}, clusterSet); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return false, err
+			}
+			setSpec = &clusterSet.Spec
+		} else {
+			set := &libsveltosv1beta1.Set{}
+			if err := instance.GetResource(ctx, types.NamespacedName{Namespace: namespace, Name: setName},
+				set); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return false, err
+			}
+			setSpec = &set.Spec
+		}
+		if containsSveltosCluster(setSpec.ClusterRefs, sveltosCluster) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func containsSveltosCluster(refs []corev1.ObjectReference, sveltosCluster *libsveltosv1beta1.SveltosCluster) bool {
+	for i := range refs {
+		if refs[i].Kind == libsveltosv1beta1.SveltosClusterKind && refs[i].Namespace == sveltosCluster.Namespace &&
+			refs[i].Name == sveltosCluster.Name {
+
+			return true
+		}
+	}
+	return false
+}
+
+// getClusterSummaryListOptions returns the options to list the ClusterSummaries of a SveltosCluster
+func getClusterSummaryListOptions(sveltosCluster *libsveltosv1beta1.SveltosCluster) []client.ListOption {
+	return []client.ListOption{
+		client.InNamespace(sveltosCluster.Namespace),
+		client.MatchingLabels{
+			configv1beta1.ClusterNameLabel: sveltosCluster.Name,
+			configv1beta1.ClusterTypeLabel: string(libsveltosv1beta1.ClusterTypeSveltos),
+		},
+	}
+}
+
+// deleteClusterSummaries deletes all ClusterSummaries matching listOptions which are not
+// already being deleted. Returns the names of the existing ClusterSummaries.
+func deleteClusterSummaries(ctx context.Context, c client.Client, listOptions []client.ListOption,
+	logger logr.Logger, opts ...client.DeleteOption) ([]string, error) {
+
+	clusterSummaries := &configv1beta1.ClusterSummaryList{}
+	if err := c.List(ctx, clusterSummaries, listOptions...); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterSummaries: %w", err)
+	}
+
+	names := make([]string, len(clusterSummaries.Items))
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		names[i] = cs.Name
+		if !cs.DeletionTimestamp.IsZero() {
+			continue
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("deleting ClusterSummary %s/%s", cs.Namespace, cs.Name))
+		if err := c.Delete(ctx, cs, opts...); err != nil && !apierrors.IsNotFound(err) {
+			return names, fmt.Errorf("failed to delete ClusterSummary %s/%s: %w", cs.Namespace, cs.Name, err)
+		}
+	}
+
+	return names, nil
+}
+
+// verifyNotManagementCluster returns an error if the managed cluster is the management cluster.
+// Clusters are compared by the UID of their kube-system namespace.
+func verifyNotManagementCluster(ctx context.Context, managementClient, managedClient client.Client) error {
+	managementUID, err := getKubeSystemUID(ctx, managementClient)
+	if err != nil {
+		return fmt.Errorf("failed to get management cluster kube-system namespace: %w", err)
+	}
+
+	managedUID, err := getKubeSystemUID(ctx, managedClient)
+	if err != nil {
+		return fmt.Errorf("failed to get managed cluster kube-system namespace: %w", err)
+	}
+
+	if managementUID == managedUID {
+		return fmt.Errorf("managed cluster is the management cluster: refusing to remove Sveltos from it")
+	}
+
+	return nil
+}
+
+func getKubeSystemUID(ctx context.Context, c client.Client) (types.UID, error) {
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: kubeSystemNamespace}, ns); err != nil {
+		return "", err
+	}
+	return ns.UID, nil
+}
+
+// getAgentClusterObjects returns the cluster wide resources that, in push mode, Sveltos creates in
+// the managed cluster for sveltos-agent and drift-detection-manager
+func getAgentClusterObjects() ([]*unstructured.Unstructured, error) {
+	objects := make([]*unstructured.Unstructured, 0)
+	for _, name := range agentClusterRoleBindings {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(rbacv1.SchemeGroupVersion.String())
+		u.SetKind("ClusterRoleBinding")
+		u.SetName(name)
+		objects = append(objects, u)
+	}
+	for _, name := range agentClusterRoles {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(rbacv1.SchemeGroupVersion.String())
+		u.SetKind("ClusterRole")
+		u.SetName(name)
+		objects = append(objects, u)
+	}
+
+	crds := [][]byte{
+		crd.GetClassifierCRDYAML(), crd.GetClassifierReportCRDYAML(),
+		crd.GetHealthCheckCRDYAML(), crd.GetHealthCheckReportCRDYAML(),
+		crd.GetEventSourceCRDYAML(), crd.GetEventReportCRDYAML(),
+		crd.GetReloaderCRDYAML(), crd.GetReloaderReportCRDYAML(),
+		crd.GetDebuggingConfigurationCRDYAML(), crd.GetResourceSummaryCRDYAML(),
+	}
+	for i := range crds {
+		u, err := k8s_utils.GetUnstructured(crds[i])
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, u)
+	}
+
+	return objects, nil
+}
+
+// getManagedClusterObjects returns the existing cluster wide sveltos-applier, sveltos-agent and
+// drift-detection-manager resources and the projectsveltos namespace, where Sveltos agents run,
+// in the managed cluster
+func getManagedClusterObjects(ctx context.Context, c client.Client) ([]*unstructured.Unstructured, error) {
+	elements, err := deployer.CustomSplit(string(agent.GetSveltosAgentYAML()))
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*unstructured.Unstructured, 0)
+	for i := range elements {
+		u, err := k8s_utils.GetUnstructured([]byte(elements[i]))
+		if err != nil {
+			return nil, err
+		}
+		// Namespaced resources are removed with the namespace. Namespace is removed last.
+		if u.GetNamespace() != "" || u.GetKind() == "Namespace" {
+			continue
+		}
+		objects = append(objects, u)
+	}
+
+	agentObjects, err := getAgentClusterObjects()
+	if err != nil {
+		return nil, err
+	}
+	objects = append(objects, agentObjects...)
+
+	ns := &unstructured.Unstructured{}
+	ns.SetAPIVersion("v1")
+	ns.SetKind("Namespace")
+	ns.SetName(applierNamespace)
+	objects = append(objects, ns)
+
+	existing := make([]*unstructured.Unstructured, 0, len(objects))
+	for i := range objects {
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(objects[i].GroupVersionKind())
+		err := c.Get(ctx, types.NamespacedName{Name: objects[i].GetName()}, current)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s %s in managed cluster: %w",
+				objects[i].GetKind(), objects[i].GetName(), err)
+		}
+		existing = append(existing, current)
+	}
+
+	return existing, nil
+}
+
+// deleteManagedClusterObjects deletes objects from the managed cluster
+func deleteManagedClusterObjects(ctx context.Context, c client.Client, objects []*unstructured.Unstructured,
+	logger logr.Logger, opts ...client.DeleteOption) error {
+
+	for i := range objects {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("deleting %s %s", objects[i].GetKind(), objects[i].GetName()))
+		if err := c.Delete(ctx, objects[i], opts...); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s %s in managed cluster: %w",
+				objects[i].GetKind(), objects[i].GetName(), err)
+		}
+	}
+
+	return nil
+}
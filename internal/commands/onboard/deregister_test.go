@@ -17,7 +17,11 @@ limitations under the License.
 package onboard_test
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -26,16 +30,28 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2/textlogger"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
 	"github.com/projectsveltos/sveltosctl/internal/commands/onboard"
 	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
+// kubeSystem returns a kube-system namespace with a random UID, which identifies a cluster
+func kubeSystem() *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "kube-system",
+			UID:  types.UID(randomString()),
+		},
+	}
+}
+
 var _ = Describe("DeregisterCluster", func() {
 	It("deregisterSveltosCluster removes SveltosCluster and kubeconfig Secret in push mode", func() {
 		clusterNamespace := randomString()
@@ -371,4 +387,333 @@ var _ = Describe("DeregisterCluster", func() {
 		Expect(onboard.DeleteClusterRoleBinding(context.TODO(), c, clusterNamespace, clusterName,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))).To(Succeed())
 	})
+
+	It("deregisterSveltosClusterWithCleanup withdraws add-ons and removes Sveltos from the managed cluster", func() {
+		clusterNamespace := randomString()
+		clusterName := randomString()
+
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      clusterName,
+				Labels:    map[string]string{"env": "prod"},
+			},
+			Spec: libsveltosv1beta1.SveltosClusterSpec{
+				PullMode: true,
+			},
+		}
+
+		clusterLabels := map[string]string{
+			configv1beta1.ClusterNameLabel: clusterName,
+			configv1beta1.ClusterTypeLabel: string(libsveltosv1beta1.ClusterTypeSveltos),
+		}
+		clusterSummary := &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+				Labels:    clusterLabels,
+			},
+		}
+
+		profileName := randomString()
+		clusterConfiguration := &configv1beta1.ClusterConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      clusterName,
+				Labels:    clusterLabels,
+			},
+			Status: configv1beta1.ClusterConfigurationStatus{
+				ClusterProfileResources: []configv1beta1.ClusterProfileResource{
+					{
+						ClusterProfileName: profileName,
+						Features: []configv1beta1.Feature{
+							{
+								FeatureID: libsveltosv1beta1.FeatureResources,
+								Resources: []configv1beta1.DeployedResource{
+									{Kind: "ConfigMap", Namespace: "default", Name: "deployed-by-sveltos"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(sveltosCluster, clusterSummary, clusterConfiguration, kubeSystem()).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		managedClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kubeSystem(),
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "projectsveltos"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "sveltos-applier-manager-role"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "sveltos-agent-manager-role"}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "drift-detection-manager-rolebinding"}},
+		).Build()
+		onboard.SetManagedClient(managedClient)
+
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		var out bytes.Buffer
+		Expect(onboard.DeregisterSveltosClusterWithCleanup(context.TODO(), clusterNamespace, clusterName,
+			onboard.NewCleanupOptions(true, time.Second, &rest.Config{}, utils.DryRunNone, true), nil, &out,
+			logger)).To(Succeed())
+
+		Expect(out.String()).To(ContainSubstring("deployed-by-sveltos"))
+		Expect(out.String()).To(ContainSubstring("ClusterProfile/" + profileName))
+		Expect(out.String()).To(ContainSubstring("Add-ons withdrawn"))
+
+		err = c.Get(context.TODO(), types.NamespacedName{Namespace: clusterNamespace, Name: clusterSummary.Name},
+			&configv1beta1.ClusterSummary{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = c.Get(context.TODO(), types.NamespacedName{Namespace: clusterNamespace, Name: clusterName},
+			&libsveltosv1beta1.SveltosCluster{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		err = managedClient.Get(context.TODO(), types.NamespacedName{Name: "projectsveltos"}, &corev1.Namespace{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = managedClient.Get(context.TODO(), types.NamespacedName{Name: "sveltos-applier-manager-role"},
+			&rbacv1.ClusterRole{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = managedClient.Get(context.TODO(), types.NamespacedName{Name: "sveltos-agent-manager-role"},
+			&rbacv1.ClusterRole{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = managedClient.Get(context.TODO(), types.NamespacedName{Name: "drift-detection-manager-rolebinding"},
+			&rbacv1.ClusterRoleBinding{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("deregisterSveltosClusterWithCleanup refuses to clean up the management cluster", func() {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "mgmt",
+				Name:      "mgmt",
+			},
+		}
+
+		managementKubeSystem := kubeSystem()
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster, managementKubeSystem).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		// Managed cluster is the management cluster: same kube-system namespace
+		managedClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(managementKubeSystem.DeepCopy(),
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "projectsveltos"}},
+		).Build()
+		onboard.SetManagedClient(managedClient)
+
+		err = onboard.DeregisterSveltosClusterWithCleanup(context.TODO(), sveltosCluster.Namespace, sveltosCluster.Name,
+			onboard.NewCleanupOptions(false, time.Second, &rest.Config{}, utils.DryRunNone, true), nil, io.Discard,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("management cluster"))
+
+		Expect(managedClient.Get(context.TODO(), types.NamespacedName{Name: "projectsveltos"},
+			&corev1.Namespace{})).To(Succeed())
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: sveltosCluster.Namespace,
+			Name: sveltosCluster.Name}, &libsveltosv1beta1.SveltosCluster{})).To(Succeed())
+	})
+
+	It("deregisterSveltosClusterWithCleanup restores SveltosCluster labels when add-ons cannot be withdrawn", func() {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+				Labels:    map[string]string{"env": "prod"},
+			},
+		}
+
+		// ClusterSummary is never removed, as the addon-controller is not running
+		clusterSummary := &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  sveltosCluster.Namespace,
+				Name:       randomString(),
+				Finalizers: []string{configv1beta1.ClusterSummaryFinalizer},
+				Labels: map[string]string{
+					configv1beta1.ClusterNameLabel: sveltosCluster.Name,
+					configv1beta1.ClusterTypeLabel: string(libsveltosv1beta1.ClusterTypeSveltos),
+				},
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster, clusterSummary, kubeSystem()).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+		onboard.SetManagedClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(kubeSystem()).Build())
+
+		var out bytes.Buffer
+		err = onboard.DeregisterSveltosClusterWithCleanup(context.TODO(), sveltosCluster.Namespace, sveltosCluster.Name,
+			onboard.NewCleanupOptions(true, time.Second, &rest.Config{}, utils.DryRunNone, true), nil, &out,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("timed out"))
+
+		current := &libsveltosv1beta1.SveltosCluster{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: sveltosCluster.Namespace,
+			Name: sveltosCluster.Name}, current)).To(Succeed())
+		Expect(current.Labels).To(HaveKeyWithValue("env", "prod"))
+	})
+
+	It("deregisterSveltosClusterWithCleanup refuses to withdraw add-ons of profiles referencing the cluster", func() {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+				Labels:    map[string]string{"env": "prod"},
+			},
+		}
+
+		clusterSet := &libsveltosv1beta1.ClusterSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: libsveltosv1beta1.Spec{
+				ClusterRefs: []corev1.ObjectReference{
+					{
+						Kind:       libsveltosv1beta1.SveltosClusterKind,
+						APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace:  sveltosCluster.Namespace,
+						Name:       sveltosCluster.Name,
+					},
+				},
+			},
+		}
+
+		clusterProfile := &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: configv1beta1.Spec{
+				SetRefs: []string{clusterSet.Name},
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(sveltosCluster, clusterSet, clusterProfile, kubeSystem()).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+		onboard.SetManagedClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(kubeSystem()).Build())
+
+		var out bytes.Buffer
+		err = onboard.DeregisterSveltosClusterWithCleanup(context.TODO(), sveltosCluster.Namespace, sveltosCluster.Name,
+			onboard.NewCleanupOptions(true, time.Second, &rest.Config{}, utils.DryRunNone, true), nil, &out,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(configv1beta1.ClusterProfileKind + "/" + clusterProfile.Name))
+
+		current := &libsveltosv1beta1.SveltosCluster{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: sveltosCluster.Namespace,
+			Name: sveltosCluster.Name}, current)).To(Succeed())
+		Expect(current.Labels).To(HaveKeyWithValue("env", "prod"))
+	})
+
+	It("deregisterSveltosClusterWithCleanup requires managed cluster access for clusters in pull mode", func() {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+			},
+			Spec: libsveltosv1beta1.SveltosClusterSpec{
+				PullMode: true,
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		var out bytes.Buffer
+		err = onboard.DeregisterSveltosClusterWithCleanup(context.TODO(), sveltosCluster.Namespace,
+			sveltosCluster.Name, onboard.NewCleanupOptions(false, time.Second, nil, utils.DryRunNone, true), nil, &out,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("pull mode"))
+
+		// Nothing is removed
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: sveltosCluster.Namespace, Name: sveltosCluster.Name},
+			&libsveltosv1beta1.SveltosCluster{})).To(Succeed())
+	})
+
+	It("deregisterSveltosClusterWithCleanup makes no changes in client dry-run mode or when not confirmed", func() {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+				Labels:    map[string]string{"env": "prod"},
+			},
+		}
+		kubeconfigSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: sveltosCluster.Namespace,
+				Name:      sveltosCluster.Name + onboard.SveltosKubeconfigSecretNamePostfix,
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster, kubeconfigSecret, kubeSystem()).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		managedClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kubeSystem(),
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "projectsveltos"}},
+		).Build()
+		onboard.SetManagedClient(managedClient)
+
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		var out bytes.Buffer
+		Expect(onboard.DeregisterSveltosClusterWithCleanup(context.TODO(), sveltosCluster.Namespace,
+			sveltosCluster.Name, onboard.NewCleanupOptions(true, time.Second, &rest.Config{}, utils.DryRunClient, false),
+			nil, &out, logger)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Namespace/projectsveltos"))
+		Expect(out.String()).To(ContainSubstring("Secret/" + kubeconfigSecret.Namespace + "/" + kubeconfigSecret.Name))
+		Expect(out.String()).To(ContainSubstring("remove labels"))
+
+		// User does not confirm
+		out.Reset()
+		err = onboard.DeregisterSveltosClusterWithCleanup(context.TODO(), sveltosCluster.Namespace,
+			sveltosCluster.Name, onboard.NewCleanupOptions(true, time.Second, &rest.Config{}, utils.DryRunNone, false),
+			strings.NewReader("n\n"), &out, logger)
+		Expect(err).To(MatchError(utils.ErrAborted))
+
+		currentSveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: sveltosCluster.Namespace, Name: sveltosCluster.Name},
+			currentSveltosCluster)).To(Succeed())
+		Expect(currentSveltosCluster.Labels).To(HaveKey("env"))
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: kubeconfigSecret.Namespace, Name: kubeconfigSecret.Name},
+			&corev1.Secret{})).To(Succeed())
+		Expect(managedClient.Get(context.TODO(), types.NamespacedName{Name: "projectsveltos"},
+			&corev1.Namespace{})).To(Succeed())
+	})
+
+	It("getDeregistrationChanges lists existing objects to delete", func() {
+		clusterNamespace := randomString()
+		clusterName := randomString()
+
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: clusterNamespace, Name: clusterName},
+			Spec:       libsveltosv1beta1.SveltosClusterSpec{PullMode: true},
+		}
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Namespace: clusterNamespace, Name: clusterName},
+		}
+		clusterRole := &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterNamespace + "-" + clusterName},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster, serviceAccount, clusterRole).Build()
+
+		changes, err := onboard.GetDeregistrationChanges(context.TODO(), c, clusterNamespace, clusterName)
+		Expect(err).To(BeNil())
+		Expect(changes).To(ConsistOf(
+			utils.Change{Action: "delete", Object: "ClusterRole/" + clusterRole.Name},
+			utils.Change{Action: "delete", Object: "ServiceAccount/" + clusterNamespace + "/" + clusterName},
+			utils.Change{Action: "delete", Object: "SveltosCluster/" + clusterNamespace + "/" + clusterName},
+		))
+	})
 })
@@ -16,6 +16,15 @@ limitations under the License.
 
 package onboard
 
+import (
+	"time"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
 var (
 	OnboardSveltosCluster    = onboardSveltosCluster
 	PrepareApplierYAML       = prepareApplierYAML
@@ -34,3 +43,41 @@ const (
 	SveltosKubeconfigSecretNamePostfix = sveltosKubeconfigSecretNamePostfix
 	Kubeconfig                         = kubeconfig
 )
+
+var (
+	LoadClusterRegistrations   = loadClusterRegistrations
+	GetAllContextRegistrations = getAllContextRegistrations
+	BulkRegisterClusters       = registerClusters
+)
+
+var (
+	LoadApplierValues = loadApplierValues
+)
+
+var (
+	DeployApplier = deployApplier
+)
+
+func SetManagedClient(c client.Client) {
+	getManagedClient = func(*rest.Config) (client.Client, error) {
+		return c, nil
+	}
+	applierPollInterval = 10 * time.Millisecond
+}
+
+var (
+	DeregisterSveltosClusterWithCleanup = deregisterSveltosClusterWithCleanup
+	GetDeregistrationChanges            = getDeregistrationChanges
+)
+
+func NewCleanupOptions(withdraw bool, timeout time.Duration, managedConfig *rest.Config,
+	dryRun utils.DryRunMode, yes bool) *cleanupOptions {
+
+	return &cleanupOptions{
+		withdraw:      withdraw,
+		timeout:       timeout,
+		managedConfig: managedConfig,
+		dryRun:        dryRun,
+		yes:           yes,
+	}
+}
@@ -45,8 +45,10 @@ import (
 	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
+// onboardSveltosClusterInPullMode creates, in the management cluster, the SveltosCluster and all
+// resources sveltos-applier needs. It returns the YAML to apply to the managed cluster.
 func onboardSveltosClusterInPullMode(ctx context.Context, clusterNamespace, clusterName string,
-	labels map[string]string, logger logr.Logger) error {
+	labels map[string]string, values *applierValues, logger logr.Logger) (string, error) {
 
 	instance := utils.GetAccessInstance()
 	c := instance.GetClient()
@@ -54,49 +56,49 @@ func onboardSveltosClusterInPullMode(ctx context.Context, clusterNamespace, clus
 	err := createNamespace(ctx, c, clusterNamespace)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createNamespace failed: %s", err))
-		return err
+		return "", err
 	}
 
 	err = createServiceAccount(ctx, c, clusterNamespace, clusterName)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createNamespace failed: %s", err))
-		return err
+		return "", err
 	}
 
 	err = createSecret(ctx, c, clusterNamespace, clusterName)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createSecret failed: %s", err))
-		return err
+		return "", err
 	}
 
 	err = createRole(ctx, c, clusterNamespace, clusterName)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createRole failed: %s", err))
-		return err
+		return "", err
 	}
 
 	err = createClusterRole(ctx, c, clusterNamespace, clusterName)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createRole failed: %s", err))
-		return err
+		return "", err
 	}
 
 	err = createRoleBinding(ctx, c, clusterNamespace, clusterName)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createRoleBinding failed: %s", err))
-		return err
+		return "", err
 	}
 
 	err = createClusterRoleBinding(ctx, c, clusterNamespace, clusterName)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createClusterRoleBinding failed: %s", err))
-		return err
+		return "", err
 	}
 
 	err = createSveltosCluster(ctx, c, clusterNamespace, clusterName, labels)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("createSveltosCluster failed: %s", err))
-		return err
+		return "", err
 	}
 
 	config := instance.GetConfig()
@@ -104,18 +106,10 @@ func onboardSveltosClusterInPullMode(ctx context.Context, clusterNamespace, clus
 	kubeconfig, err := getKubeconfig(ctx, c, clusterNamespace, clusterName, config.Host)
 	if err != nil {
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("getKubeconfig failed: %s", err))
-		return err
-	}
-
-	toApplyYAML, err := prepareApplierYAML(kubeconfig, clusterNamespace, clusterName, logger)
-	if err != nil {
-		return err
+		return "", err
 	}
 
-	//nolint: forbidigo // this is printing the YAML to apply to managed cluster
-	fmt.Printf("%s", toApplyYAML)
-
-	return nil
+	return prepareApplierYAML(kubeconfig, clusterNamespace, clusterName, values, logger)
 }
 
 func modifyDeployment(depl *appsv1.Deployment, clusterNamespace, clusterName string,
@@ -608,7 +602,9 @@ rules:
 `
 )
 
-func prepareApplierYAML(kubeconfig, clusterNamespace, clusterName string,
+// prepareApplierYAML returns the sveltos-applier manifest to deploy in the managed cluster.
+// values, if not nil, customizes the default manifest.
+func prepareApplierYAML(kubeconfig, clusterNamespace, clusterName string, values *applierValues,
 	logger logr.Logger) (string, error) {
 
 	applierYAML := agent.GetSveltosAgentYAML()
@@ -638,6 +634,9 @@ func prepareApplierYAML(kubeconfig, clusterNamespace, clusterName string,
 			if err != nil {
 				return "", err
 			}
+			if values != nil {
+				values.customizeDeployment(depl)
+			}
 
 			unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&depl)
 			if err != nil {
@@ -645,6 +644,21 @@ func prepareApplierYAML(kubeconfig, clusterNamespace, clusterName string,
 				return "", err
 			}
 
+			policy.SetUnstructuredContent(unstructuredObj)
+		} else if policy.GetKind() == "ClusterRole" && values != nil {
+			clusterRole := &rbacv1.ClusterRole{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(policy.Object, clusterRole); err != nil {
+				return "", err
+			}
+
+			values.customizeClusterRole(clusterRole)
+
+			unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(clusterRole)
+			if err != nil {
+				logger.V(logs.LogDebug).Info(fmt.Sprintf("failed to convert clusterRole instance to unstructured: %v", err))
+				return "", err
+			}
+
 			policy.SetUnstructuredContent(unstructuredObj)
 		}
 
@@ -663,7 +677,7 @@ func prepareApplierYAML(kubeconfig, clusterNamespace, clusterName string,
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "projectsveltos",
+			Namespace: applierNamespace,
 			Name:      getSecretName(clusterName),
 		},
 		Data: map[string][]byte{
@@ -687,6 +701,27 @@ func prepareApplierYAML(kubeconfig, clusterNamespace, clusterName string,
 	final += separator
 	final += resourceYAML
 
+	// ConfigMap with extra CA certificates mounted by sveltos-applier
+	if values != nil {
+		if configMap := values.getCABundleConfigMap(); configMap != nil {
+			unstructuredObj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(configMap)
+			if err != nil {
+				logger.V(logs.LogDebug).Info(fmt.Sprintf("failed to convert configMap instance to unstructured: %v", err))
+				return "", err
+			}
+			policy = &unstructured.Unstructured{}
+			policy.SetUnstructuredContent(unstructuredObj)
+
+			resourceYAML, err = getYAMLFromUnstructured(policy)
+			if err != nil {
+				return "", err
+			}
+
+			final += separator
+			final += resourceYAML
+		}
+	}
+
 	return final, nil
 }
 
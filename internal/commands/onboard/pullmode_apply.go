@@ -0,0 +1,184 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onboard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/deployer"
+	"github.com/projectsveltos/libsveltos/lib/k8s_utils"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	applierDeploymentName = "sveltos-applier-manager"
+	applierFieldOwner     = "sveltosctl"
+	defaultApplyTimeout   = 5 * time.Minute
+)
+
+var (
+	applierPollInterval = 2 * time.Second
+
+	// getManagedClient returns the client used to apply sveltos-applier to the managed cluster
+	getManagedClient = func(config *rest.Config) (client.Client, error) {
+		return client.New(config, client.Options{})
+	}
+)
+
+// deployApplier applies the sveltos-applier YAML to the managed cluster, then waits for the
+// sveltos-applier Deployment to be ready and for the SveltosCluster to be Ready in the
+// management cluster.
+func deployApplier(ctx context.Context, config *rest.Config, clusterNamespace, clusterName, toApplyYAML string,
+	timeout time.Duration, out io.Writer, logger logr.Logger) error {
+
+	c, err := getManagedClient(config)
+	if err != nil {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("failed to get managed cluster client: %v", err))
+		return err
+	}
+
+	if err := applyApplierYAML(ctx, c, toApplyYAML, logger); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "sveltos-applier applied to managed cluster %s/%s\n", clusterNamespace, clusterName)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := waitForApplierDeployment(ctx, c, logger); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "sveltos-applier deployment is ready\n")
+
+	if err := waitForSveltosClusterReady(ctx, clusterNamespace, clusterName, logger); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "SveltosCluster %s/%s is ready\n", clusterNamespace, clusterName)
+
+	return nil
+}
+
+// applyApplierYAML server-side applies each resource in toApplyYAML
+func applyApplierYAML(ctx context.Context, c client.Client, toApplyYAML string, logger logr.Logger) error {
+	elements, err := deployer.CustomSplit(toApplyYAML)
+	if err != nil {
+		return err
+	}
+
+	for i := range elements {
+		u, err := k8s_utils.GetUnstructured([]byte(elements[i]))
+		if err != nil {
+			logger.V(logs.LogDebug).Info(fmt.Sprintf("failed to parse applier yaml: %v", err))
+			return err
+		}
+
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("applying %s %s/%s", u.GetKind(), u.GetNamespace(), u.GetName()))
+		err = c.Apply(ctx, client.ApplyConfigurationFromUnstructured(u),
+			client.FieldOwner(applierFieldOwner), client.ForceOwnership)
+		if err != nil {
+			return fmt.Errorf("failed to apply %s %s/%s: %w", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// waitForApplierDeployment waits till all replicas of the sveltos-applier Deployment are
+// updated and available
+func waitForApplierDeployment(ctx context.Context, c client.Client, logger logr.Logger) error {
+	ticker := time.NewTicker(applierPollInterval)
+	defer ticker.Stop()
+
+	key := types.NamespacedName{Namespace: applierNamespace, Name: applierDeploymentName}
+	var lastErr error
+	for {
+		depl := &appsv1.Deployment{}
+		lastErr = c.Get(ctx, key, depl)
+		if lastErr == nil {
+			if isDeploymentReady(depl) {
+				return nil
+			}
+			lastErr = fmt.Errorf("%d/%d replicas available", depl.Status.AvailableReplicas, getReplicas(depl))
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("sveltos-applier deployment not ready yet: %v", lastErr))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for sveltos-applier deployment: %w", lastErr)
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForSveltosClusterReady waits till the SveltosCluster is Ready, which happens once
+// sveltos-applier connects to the management cluster
+func waitForSveltosClusterReady(ctx context.Context, clusterNamespace, clusterName string,
+	logger logr.Logger) error {
+
+	ticker := time.NewTicker(applierPollInterval)
+	defer ticker.Stop()
+
+	instance := utils.GetAccessInstance()
+	key := types.NamespacedName{Namespace: clusterNamespace, Name: clusterName}
+	reason := "not ready"
+	for {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+		err := instance.GetResource(ctx, key, sveltosCluster)
+		switch {
+		case err != nil:
+			reason = err.Error()
+		case sveltosCluster.Status.Ready:
+			return nil
+		case sveltosCluster.Status.FailureMessage != nil:
+			reason = *sveltosCluster.Status.FailureMessage
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("SveltosCluster %s/%s not ready yet: %s",
+			clusterNamespace, clusterName, reason))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for SveltosCluster %s/%s to be ready: %s",
+				clusterNamespace, clusterName, reason)
+		case <-ticker.C:
+		}
+	}
+}
+
+func isDeploymentReady(depl *appsv1.Deployment) bool {
+	replicas := getReplicas(depl)
+	return depl.Status.ObservedGeneration >= depl.Generation &&
+		depl.Status.UpdatedReplicas == replicas &&
+		depl.Status.AvailableReplicas == replicas
+}
+
+func getReplicas(depl *appsv1.Deployment) int32 {
+	if depl.Spec.Replicas == nil {
+		return 1
+	}
+	return *depl.Spec.Replicas
+}
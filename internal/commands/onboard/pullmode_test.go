@@ -17,14 +17,31 @@ limitations under the License.
 package onboard_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/deployer"
 
 	"github.com/projectsveltos/sveltosctl/internal/commands/onboard"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
 var _ = Describe("Register cluster in pullmode", func() {
@@ -33,7 +50,7 @@ var _ = Describe("Register cluster in pullmode", func() {
 		clusterName := randomString()
 		kubeconfig := randomString()
 
-		toApply, err := onboard.PrepareApplierYAML(clusterNamespace, clusterName, kubeconfig,
+		toApply, err := onboard.PrepareApplierYAML(clusterNamespace, clusterName, kubeconfig, nil,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 		Expect(strings.Contains(toApply, fmt.Sprintf("--cluster-namespace=%s", clusterNamespace)))
@@ -41,4 +58,164 @@ var _ = Describe("Register cluster in pullmode", func() {
 		Expect(strings.Contains(toApply, "--cluster-namespace=sveltos"))
 		Expect(strings.Contains(toApply, fmt.Sprintf("--secret-with-kubeconfig=%s-sveltos-kubeconfig", clusterName)))
 	})
+
+	It("prepareApplierYAML customizes sveltos-applier with applier values", func() {
+		valuesFile := filepath.Join(GinkgoT().TempDir(), "values.yaml")
+		Expect(os.WriteFile(valuesFile, []byte(`image:
+  registry: registry.internal:5000
+  digest: sha256:0123456789abcdef
+resources:
+  limits:
+    memory: 256Mi
+nodeSelector:
+  node-role.kubernetes.io/infra: ""
+tolerations:
+- key: infra
+  operator: Exists
+  effect: NoSchedule
+proxy:
+  httpsProxy: http://proxy.internal:3128
+  noProxy: 10.0.0.0/8,.svc
+extraCABundle: |
+  -----BEGIN CERTIFICATE-----
+  -----END CERTIFICATE-----
+clusterRoleRules:
+- apiGroups: [""]
+  resources: ["configmaps"]
+  verbs: ["get", "list", "watch", "create", "update", "patch", "delete"]
+`), 0o600)).To(Succeed())
+
+		values, err := onboard.LoadApplierValues(valuesFile)
+		Expect(err).To(BeNil())
+
+		toApply, err := onboard.PrepareApplierYAML(randomString(), randomString(), randomString(), values,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		elements, err := deployer.CustomSplit(toApply)
+		Expect(err).To(BeNil())
+
+		var depl *appsv1.Deployment
+		var clusterRole *rbacv1.ClusterRole
+		var configMap *corev1.ConfigMap
+		for i := range elements {
+			switch {
+			case strings.Contains(elements[i], "kind: Deployment"):
+				depl = &appsv1.Deployment{}
+				Expect(yaml.Unmarshal([]byte(elements[i]), depl)).To(Succeed())
+			case strings.Contains(elements[i], "\nkind: ClusterRole\n"):
+				clusterRole = &rbacv1.ClusterRole{}
+				Expect(yaml.Unmarshal([]byte(elements[i]), clusterRole)).To(Succeed())
+			case strings.Contains(elements[i], "kind: ConfigMap"):
+				configMap = &corev1.ConfigMap{}
+				Expect(yaml.Unmarshal([]byte(elements[i]), configMap)).To(Succeed())
+			}
+		}
+		Expect(depl).ToNot(BeNil())
+		Expect(clusterRole).ToNot(BeNil())
+		Expect(configMap).ToNot(BeNil())
+
+		podSpec := depl.Spec.Template.Spec
+		Expect(podSpec.NodeSelector).To(HaveKey("node-role.kubernetes.io/infra"))
+		Expect(podSpec.Tolerations).To(HaveLen(1))
+		Expect(podSpec.Volumes).To(ContainElement(HaveField("ConfigMap.Name", configMap.Name)))
+
+		container := podSpec.Containers[0]
+		Expect(container.Image).To(Equal("registry.internal:5000/projectsveltos/sveltos-applier@sha256:0123456789abcdef"))
+		Expect(container.Resources.Limits.Memory().Equal(resource.MustParse("256Mi"))).To(BeTrue())
+		Expect(container.Resources.Requests).To(BeEmpty())
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy.internal:3128"}))
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "NO_PROXY", Value: "10.0.0.0/8,.svc"}))
+		Expect(container.Env).To(ContainElement(HaveField("Name", "SSL_CERT_DIR")))
+		Expect(container.VolumeMounts).To(HaveLen(1))
+
+		Expect(clusterRole.Rules).To(HaveLen(1))
+		Expect(clusterRole.Rules[0].Resources).To(Equal([]string{"configmaps"}))
+		Expect(configMap.Data).To(HaveKey("ca-bundle.crt"))
+	})
+
+	It("loadApplierValues rejects unknown fields and tag with digest", func() {
+		valuesFile := filepath.Join(GinkgoT().TempDir(), "values.yaml")
+		Expect(os.WriteFile(valuesFile, []byte("replicas: 2\n"), 0o600)).To(Succeed())
+		_, err := onboard.LoadApplierValues(valuesFile)
+		Expect(err).ToNot(BeNil())
+
+		Expect(os.WriteFile(valuesFile, []byte("image:\n  tag: v1.0.0\n  digest: sha256:abc\n"), 0o600)).To(Succeed())
+		_, err = onboard.LoadApplierValues(valuesFile)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("deployApplier applies sveltos-applier and waits for it and the SveltosCluster to be ready", func() {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: randomString(), Name: randomString()},
+			Spec:       libsveltosv1beta1.SveltosClusterSpec{PullMode: true},
+			Status:     libsveltosv1beta1.SveltosClusterStatus{Ready: true},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		// sveltos-applier is already running in the managed cluster
+		replicas := int32(1)
+		depl := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "projectsveltos", Name: "sveltos-applier-manager"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1},
+		}
+		managedClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(depl).Build()
+		onboard.SetManagedClient(managedClient)
+
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		toApply, err := onboard.PrepareApplierYAML(randomString(), sveltosCluster.Namespace, sveltosCluster.Name,
+			nil, logger)
+		Expect(err).To(BeNil())
+
+		var out bytes.Buffer
+		Expect(onboard.DeployApplier(context.TODO(), nil, sveltosCluster.Namespace, sveltosCluster.Name, toApply,
+			time.Second, &out, logger)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("is ready"))
+
+		secret := &corev1.Secret{}
+		Expect(managedClient.Get(context.TODO(),
+			types.NamespacedName{Namespace: "projectsveltos", Name: sveltosCluster.Name + "-sveltos-kubeconfig"},
+			secret)).To(Succeed())
+		clusterRole := &rbacv1.ClusterRole{}
+		Expect(managedClient.Get(context.TODO(),
+			types.NamespacedName{Name: "sveltos-applier-manager-role"}, clusterRole)).To(Succeed())
+	})
+
+	It("deployApplier fails when the SveltosCluster does not become ready", func() {
+		failure := "failed to connect"
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: randomString(), Name: randomString()},
+			Spec:       libsveltosv1beta1.SveltosClusterSpec{PullMode: true},
+			Status:     libsveltosv1beta1.SveltosClusterStatus{FailureMessage: &failure},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		replicas := int32(1)
+		depl := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "projectsveltos", Name: "sveltos-applier-manager"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1},
+		}
+		onboard.SetManagedClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(depl).Build())
+
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		toApply, err := onboard.PrepareApplierYAML(randomString(), sveltosCluster.Namespace, sveltosCluster.Name,
+			nil, logger)
+		Expect(err).To(BeNil())
+
+		var out bytes.Buffer
+		err = onboard.DeployApplier(context.TODO(), nil, sveltosCluster.Namespace, sveltosCluster.Name, toApply,
+			100*time.Millisecond, &out, logger)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(failure))
+	})
 })
@@ -0,0 +1,139 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	docopt "github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/commands/pause"
+)
+
+const (
+	profileCommand = "profile"
+)
+
+// Pause takes keyword pause and calls the corresponding subcommand
+func Pause(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+	sveltosctl pause <command> [<args>...]
+
+	profile       Pauses a ClusterProfile/Profile, freezing its rollout in all matching clusters.
+
+Options:
+	-h --help      Show this screen.
+
+Description:
+	See 'sveltosctl pause <command> --help' to read about a specific subcommand.
+  `
+
+	return runPauseCommand(ctx, doc, profileCommand, pause.Profile, logger)
+}
+
+// Resume takes keyword resume and calls the corresponding subcommand
+func Resume(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+	sveltosctl resume <command> [<args>...]
+
+	profile       Resumes a previously paused ClusterProfile/Profile.
+
+Options:
+	-h --help      Show this screen.
+
+Description:
+	See 'sveltosctl resume <command> --help' to read about a specific subcommand.
+  `
+
+	return runPauseCommand(ctx, doc, profileCommand, pause.ResumeProfile, logger)
+}
+
+// Cordon takes keyword cordon and calls the corresponding subcommand
+func Cordon(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+	sveltosctl cordon <command> [<args>...]
+
+	cluster       Stops Sveltos from deploying add-on changes to a cluster.
+
+Options:
+	-h --help      Show this screen.
+
+Description:
+	See 'sveltosctl cordon <command> --help' to read about a specific subcommand.
+  `
+
+	return runPauseCommand(ctx, doc, clusterCommand, pause.CordonCluster, logger)
+}
+
+// Uncordon takes keyword uncordon and calls the corresponding subcommand
+func Uncordon(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+	sveltosctl uncordon <command> [<args>...]
+
+	cluster       Resumes deploying add-on changes to a previously cordoned cluster.
+
+Options:
+	-h --help      Show this screen.
+
+Description:
+	See 'sveltosctl uncordon <command> --help' to read about a specific subcommand.
+  `
+
+	return runPauseCommand(ctx, doc, clusterCommand, pause.UncordonCluster, logger)
+}
+
+// runPauseCommand parses the arguments and invokes fn if the subcommand matches
+func runPauseCommand(ctx context.Context, doc, subcommand string,
+	fn func(context.Context, []string, logr.Logger) error, logger logr.Logger) error {
+
+	parser := &docopt.Parser{
+		HelpHandler:   docopt.PrintHelpAndExit,
+		OptionsFirst:  true,
+		SkipHelpFlags: false,
+	}
+
+	opts, err := parser.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		var userError docopt.UserError
+		if errors.As(err, &userError) {
+			logger.V(logs.LogInfo).Info(fmt.Sprintf(
+				"Invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand.\n",
+				strings.Join(os.Args[1:], " "),
+			))
+		}
+		os.Exit(1)
+	}
+
+	command := opts["<command>"].(string)
+	arguments := append([]string{"pause", command}, opts["<args>"].([]string)...)
+
+	switch command {
+	case subcommand:
+		return fn(ctx, arguments, logger)
+	default:
+		//nolint: forbidigo // print doc
+		fmt.Println(doc)
+	}
+
+	return nil
+}
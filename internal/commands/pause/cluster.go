@@ -0,0 +1,420 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/commands/redeploy"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	// CordonedByAnnotation is set on ClusterSummary instances paused by cordon cluster.
+	// Its value is who cordoned the cluster.
+	CordonedByAnnotation = "sveltosctl.projectsveltos.io/cordoned-by"
+
+	// CordonReasonAnnotation contains why the cluster was cordoned
+	CordonReasonAnnotation = "sveltosctl.projectsveltos.io/cordon-reason"
+
+	// CordonedAtAnnotation contains when, in RFC3339 format, the cluster was cordoned
+	CordonedAtAnnotation = "sveltosctl.projectsveltos.io/cordoned-at"
+
+	// PausedByCordonAnnotation is set on the SveltosCluster/Cluster paused by cordon cluster.
+	// Clusters paused before being cordoned do not have it and stay paused on uncordon.
+	PausedByCordonAnnotation = "sveltosctl.projectsveltos.io/paused-by-cordon"
+)
+
+// cordonInfo contains who cordoned a cluster and why
+type cordonInfo struct {
+	by     string
+	reason string
+}
+
+// setClusterCordoned cordons (or uncordons) a cluster. Cordoning pauses the SveltosCluster/Cluster,
+// so no ClusterSummary (including the ones created later) deploys to it, and all the existing
+// ClusterSummary instances of the cluster, recording who cordoned it and why. Uncordoning resumes
+// the cluster and then the ClusterSummary instances paused by cordon, in dependency order.
+// Objects to patch are printed on out and, unless yes is set or in dry-run mode, user is asked
+// for confirmation on in.
+func setClusterCordoned(ctx context.Context, namespace, cluster string, clusterType libsveltosv1beta1.ClusterType,
+	info *cordonInfo, dryRun utils.DryRunMode, yes bool, in io.Reader, out io.Writer, logger logr.Logger) error {
+
+	cordon := info != nil
+	clusterInfo := fmt.Sprintf("%s:%s/%s", clusterType, namespace, cluster)
+
+	c := utils.GetAccessInstance().GetClient()
+	clusterObj, err := clusterproxy.GetCluster(ctx, c, namespace, cluster, clusterType)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster %s: %w", clusterInfo, err)
+	}
+
+	order, csMap, err := redeploy.GetClusterSummariesInOrder(ctx, c, namespace, cluster, &clusterType)
+	if err != nil {
+		return err
+	}
+
+	if cordon {
+		// Pause dependent ClusterSummaries before the ones they depend on
+		for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+			order[i], order[j] = order[j], order[i]
+		}
+	}
+
+	toPatch := make([]*configv1beta1.ClusterSummary, 0)
+	for _, csName := range order {
+		cs := csMap[csName]
+		if _, cordoned := cs.Annotations[CordonedByAnnotation]; cordoned != cordon {
+			toPatch = append(toPatch, cs)
+		}
+	}
+
+	_, clusterCordoned := clusterObj.GetAnnotations()[CordonedByAnnotation]
+	patchCluster := clusterCordoned != cordon
+
+	if !patchCluster && len(toPatch) == 0 {
+		state := "cordoned"
+		if !cordon {
+			state = "not cordoned"
+		}
+		fmt.Fprintf(out, "cluster %s is already %s\n", clusterInfo, state)
+		return nil
+	}
+
+	changes := make([]utils.Change, 0, len(toPatch)+1)
+	if patchCluster {
+		changes = append(changes, getClusterChange(clusterObj, clusterType, info))
+	}
+	for i := range toPatch {
+		details := fmt.Sprintf("resume (step %d of %d)", i+1, len(toPatch))
+		if cordon {
+			details = fmt.Sprintf("pause (cordoned by %s: %s)", info.by, info.reason)
+		}
+		changes = append(changes, utils.Change{
+			Action:  "patch",
+			Object:  fmt.Sprintf("ClusterSummary/%s/%s", toPatch[i].Namespace, toPatch[i].Name),
+			Details: details,
+		})
+	}
+
+	apply, err := utils.ConfirmChanges(in, out, changes, dryRun, yes)
+	if err != nil || !apply {
+		return err
+	}
+
+	opts := []client.PatchOption{}
+	if dryRun == utils.DryRunServer {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	// The cluster is paused first, so no ClusterSummary deploys while those are being paused.
+	// On uncordon, it is resumed first: ClusterSummaries are then resumed one at a time.
+	if patchCluster {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("patching %s", changes[0].Object))
+		if err := patchClusterCordoned(ctx, c, clusterObj, info, now, opts...); err != nil {
+			return fmt.Errorf("failed to patch %s: %w", changes[0].Object, err)
+		}
+		changes = changes[1:]
+	}
+
+	for i := range toPatch {
+		cs := toPatch[i]
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("patching %s", changes[i].Object))
+
+		patch := client.MergeFrom(cs.DeepCopy())
+		if cordon {
+			if cs.Annotations == nil {
+				cs.Annotations = make(map[string]string)
+			}
+			cs.Annotations[clusterv1.PausedAnnotation] = "true"
+			setCordonAnnotations(cs.Annotations, info, now)
+		} else {
+			// ClusterSummary instances of a paused profile stay paused
+			profilePaused, err := isOwnerProfilePaused(ctx, cs)
+			if err != nil {
+				return err
+			}
+			if !profilePaused {
+				delete(cs.Annotations, clusterv1.PausedAnnotation)
+			}
+			removeCordonAnnotations(cs.Annotations)
+		}
+
+		if err := c.Patch(ctx, cs, patch, opts...); err != nil {
+			return fmt.Errorf("failed to patch %s: %w", changes[i].Object, err)
+		}
+	}
+
+	if dryRun == utils.DryRunServer {
+		fmt.Fprintf(out, "Dry run (server): changes to cluster %s validated\n", clusterInfo)
+		return nil
+	}
+
+	action := "cordoned"
+	if !cordon {
+		action = "uncordoned"
+	}
+	fmt.Fprintf(out, "cluster %s %s\n", clusterInfo, action)
+	return nil
+}
+
+func setCordonAnnotations(annotations map[string]string, info *cordonInfo, now string) {
+	annotations[CordonedByAnnotation] = info.by
+	annotations[CordonReasonAnnotation] = info.reason
+	annotations[CordonedAtAnnotation] = now
+}
+
+func removeCordonAnnotations(annotations map[string]string) {
+	delete(annotations, CordonedByAnnotation)
+	delete(annotations, CordonReasonAnnotation)
+	delete(annotations, CordonedAtAnnotation)
+}
+
+// getClusterChange returns the change cordon (info is not nil) or uncordon makes to the cluster
+func getClusterChange(cluster client.Object, clusterType libsveltosv1beta1.ClusterType, info *cordonInfo,
+) utils.Change {
+
+	kind := libsveltosv1beta1.SveltosClusterKind
+	if clusterType == libsveltosv1beta1.ClusterTypeCapi {
+		kind = "Cluster"
+	}
+
+	var details string
+	_, pausedByCordon := cluster.GetAnnotations()[PausedByCordonAnnotation]
+	switch {
+	case info != nil && isClusterPaused(cluster):
+		details = fmt.Sprintf("cordoned by %s: %s (cluster already paused)", info.by, info.reason)
+	case info != nil:
+		details = fmt.Sprintf("pause (cordoned by %s: %s)", info.by, info.reason)
+	case pausedByCordon:
+		details = "resume"
+	default:
+		details = "remove cordon (cluster was paused before being cordoned and stays paused)"
+	}
+
+	return utils.Change{
+		Action:  "patch",
+		Object:  fmt.Sprintf("%s/%s/%s", kind, cluster.GetNamespace(), cluster.GetName()),
+		Details: details,
+	}
+}
+
+// patchClusterCordoned pauses the cluster and records the cordon on it (info is not nil) or removes
+// the cordon, resuming the cluster if cordon paused it
+func patchClusterCordoned(ctx context.Context, c client.Client, cluster client.Object, info *cordonInfo,
+	now string, opts ...client.PatchOption) error {
+
+	patch := client.MergeFrom(cluster.DeepCopyObject().(client.Object))
+
+	annotations := cluster.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if info != nil {
+		if !isClusterPaused(cluster) {
+			setClusterPaused(cluster, true)
+			annotations[PausedByCordonAnnotation] = "true"
+		}
+		setCordonAnnotations(annotations, info, now)
+	} else {
+		if _, ok := annotations[PausedByCordonAnnotation]; ok {
+			setClusterPaused(cluster, false)
+			delete(annotations, PausedByCordonAnnotation)
+		}
+		removeCordonAnnotations(annotations)
+	}
+	cluster.SetAnnotations(annotations)
+
+	return c.Patch(ctx, cluster, patch, opts...)
+}
+
+// isClusterPaused returns true if the SveltosCluster/Cluster is paused
+func isClusterPaused(cluster client.Object) bool {
+	switch cl := cluster.(type) {
+	case *libsveltosv1beta1.SveltosCluster:
+		return cl.Spec.Paused
+	case *clusterv1.Cluster:
+		return cl.Spec.Paused != nil && *cl.Spec.Paused
+	}
+	return false
+}
+
+// setClusterPaused pauses (or resumes) the SveltosCluster/Cluster. Sveltos does not deploy
+// add-ons to paused clusters.
+func setClusterPaused(cluster client.Object, paused bool) {
+	switch cl := cluster.(type) {
+	case *libsveltosv1beta1.SveltosCluster:
+		cl.Spec.Paused = paused
+	case *clusterv1.Cluster:
+		cl.Spec.Paused = &paused
+	}
+}
+
+// isOwnerProfilePaused returns true if the ClusterProfile/Profile owning the ClusterSummary is paused
+func isOwnerProfilePaused(ctx context.Context, cs *configv1beta1.ClusterSummary) (bool, error) {
+	owner, err := configv1beta1.GetProfileOwnerReference(cs)
+	if err != nil || owner == nil {
+		// ClusterSummary not owned by any ClusterProfile/Profile
+		return false, nil //nolint: nilerr // no owner means no paused owner
+	}
+
+	namespace := ""
+	if owner.Kind == configv1beta1.ProfileKind {
+		namespace = cs.Namespace
+	}
+
+	profile, err := utils.GetAccessInstance().GetProfileInstance(ctx, owner.Kind, namespace, owner.Name)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	return utils.IsProfilePaused(profile.Object), nil
+}
+
+// getCurrentUser returns the name of the user running sveltosctl
+func getCurrentUser() string {
+	current, err := user.Current()
+	if err != nil || current.Username == "" {
+		return "unknown"
+	}
+	return current.Username
+}
+
+func runClusterCommand(ctx context.Context, doc string, args []string, cordon bool, logger logr.Logger) error {
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	namespace := parsedArgs["--namespace"].(string)
+	cluster := parsedArgs["--cluster"].(string)
+
+	clusterType, err := utils.ParseClusterType(parsedArgs["--cluster-type"].(string))
+	if err != nil {
+		return err
+	}
+
+	var info *cordonInfo
+	if cordon {
+		info = &cordonInfo{by: getCurrentUser(), reason: parsedArgs["--reason"].(string)}
+		if passedBy := parsedArgs["--by"]; passedBy != nil {
+			info.by = passedBy.(string)
+		}
+	}
+
+	dryRun, err := utils.ParseDryRun(parsedArgs["--dry-run"])
+	if err != nil {
+		return err
+	}
+
+	return setClusterCordoned(ctx, namespace, cluster, clusterType, info, dryRun, parsedArgs["--yes"].(bool),
+		os.Stdin, os.Stdout, logger)
+}
+
+// CordonCluster pauses a cluster and all its ClusterSummary instances so Sveltos stops
+// deploying add-ons to it
+func CordonCluster(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl cordon cluster [options] --namespace=<name> --cluster=<name> --cluster-type=<type> --reason=<text>
+                            [--by=<name>] [--dry-run=<mode>] [--yes] [--verbose]
+
+     --namespace=<name>     Namespace of the cluster.
+     --cluster=<name>       Name of the cluster.
+     --cluster-type=<type>  Type of the cluster. Accepted values are 'Capi' and 'Sveltos' (case insensitive).
+     --reason=<text>        Why the cluster is cordoned, for instance an incident reference.
+     --by=<name>            Who cordons the cluster. Default: the current user.
+     --dry-run=<mode>       Print the cluster and ClusterSummary instances to pause without pausing them.
+                            Accepted values: client (only print), server (also submit the changes to the API
+                            server in dry-run mode) and none.
+     --yes                  Cordon without asking for confirmation.
+
+Options:
+  -h --help                Show this screen.
+     --verbose             Verbose mode. Print each step.
+
+Description:
+  The cordon cluster command stops Sveltos from deploying add-on changes to a cluster without
+  editing any ClusterProfile/Profile. It pauses the SveltosCluster/Cluster (spec.paused), so
+  ClusterSummary instances created later, when a ClusterProfile/Profile starts matching the
+  cluster, do not deploy either. It also pauses every existing ClusterSummary of the cluster.
+  Who cordoned the cluster, why and when are recorded in annotations on the cluster and on
+  those ClusterSummary instances.
+  Pausing a CAPI Cluster also pauses its reconciliation by Cluster API.
+`
+	return runClusterCommand(ctx, doc, args, true, logger)
+}
+
+// UncordonCluster resumes the ClusterSummary instances of a cluster paused by CordonCluster
+func UncordonCluster(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl uncordon cluster [options] --namespace=<name> --cluster=<name> --cluster-type=<type>
+                              [--dry-run=<mode>] [--yes] [--verbose]
+
+     --namespace=<name>     Namespace of the cluster.
+     --cluster=<name>       Name of the cluster.
+     --cluster-type=<type>  Type of the cluster. Accepted values are 'Capi' and 'Sveltos' (case insensitive).
+     --dry-run=<mode>       Print the cluster and ClusterSummary instances to resume without resuming them.
+                            Accepted values: client (only print), server (also submit the changes to the API
+                            server in dry-run mode) and none.
+     --yes                  Uncordon without asking for confirmation.
+
+Options:
+  -h --help                Show this screen.
+     --verbose             Verbose mode. Print each step.
+
+Description:
+  The uncordon cluster command resumes the SveltosCluster/Cluster and then, in dependency order,
+  the ClusterSummary instances paused by 'sveltosctl cordon cluster'. A cluster already paused
+  when it was cordoned and ClusterSummary instances of a paused ClusterProfile/Profile stay paused.
+`
+	return runClusterCommand(ctx, doc, args, false, logger)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2/textlogger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/pause"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Cordon cluster", func() {
+	var logger logr.Logger
+	var clusterNamespace string
+	var clusterName string
+	var clusterSummary1 *configv1beta1.ClusterSummary
+	var clusterSummary2 *configv1beta1.ClusterSummary
+	var sveltosCluster *libsveltosv1beta1.SveltosCluster
+	var c client.Client
+
+	const clusterType = libsveltosv1beta1.ClusterTypeSveltos
+
+	BeforeEach(func() {
+		logger = textlogger.NewLogger(textlogger.NewConfig())
+		clusterNamespace = randomString()
+		clusterName = randomString()
+
+		labels := map[string]string{
+			configv1beta1.ClusterNameLabel: clusterName,
+			configv1beta1.ClusterTypeLabel: string(clusterType),
+		}
+
+		clusterSummary1 = &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+				Labels:    labels,
+			},
+		}
+
+		clusterSummary2 = &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+				Labels:    labels,
+			},
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterProfileSpec: configv1beta1.Spec{
+					DependsOn: []string{clusterSummary1.Name},
+				},
+			},
+		}
+
+		sveltosCluster = &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      clusterName,
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c = fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(sveltosCluster, clusterSummary1, clusterSummary2).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	})
+
+	getAnnotations := func(cs *configv1beta1.ClusterSummary) map[string]string {
+		currentClusterSummary := &configv1beta1.ClusterSummary{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: cs.Namespace, Name: cs.Name},
+			currentClusterSummary)).To(Succeed())
+		return currentClusterSummary.Annotations
+	}
+
+	getCluster := func() *libsveltosv1beta1.SveltosCluster {
+		currentCluster := &libsveltosv1beta1.SveltosCluster{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: clusterNamespace, Name: clusterName},
+			currentCluster)).To(Succeed())
+		return currentCluster
+	}
+
+	It("setClusterCordoned pauses ClusterSummaries recording who and why, and resumes them", func() {
+		Expect(pause.SetClusterCordoned(context.TODO(), clusterNamespace, clusterName, clusterType,
+			pause.NewCordonInfo("alice", "INC-42"), utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+
+		for _, cs := range []*configv1beta1.ClusterSummary{clusterSummary1, clusterSummary2} {
+			annotations := getAnnotations(cs)
+			Expect(annotations).To(HaveKey(clusterv1.PausedAnnotation))
+			Expect(annotations).To(HaveKeyWithValue(pause.CordonedByAnnotation, "alice"))
+			Expect(annotations).To(HaveKeyWithValue(pause.CordonReasonAnnotation, "INC-42"))
+			Expect(annotations).To(HaveKey(pause.CordonedAtAnnotation))
+		}
+		// Cluster is paused, so ClusterSummaries created later do not deploy either
+		currentCluster := getCluster()
+		Expect(currentCluster.Spec.Paused).To(BeTrue())
+		Expect(currentCluster.Annotations).To(HaveKeyWithValue(pause.CordonedByAnnotation, "alice"))
+		Expect(currentCluster.Annotations).To(HaveKey(pause.PausedByCordonAnnotation))
+
+		var out bytes.Buffer
+		Expect(pause.SetClusterCordoned(context.TODO(), clusterNamespace, clusterName, clusterType,
+			nil, utils.DryRunClient, false, nil, &out, logger)).To(Succeed())
+		// ClusterSummaries are resumed in dependency order
+		Expect(out.String()).To(MatchRegexp("(?s)%s.*step 1 of 2.*%s.*step 2 of 2",
+			clusterSummary1.Name, clusterSummary2.Name))
+		Expect(getAnnotations(clusterSummary1)).To(HaveKey(clusterv1.PausedAnnotation))
+
+		Expect(pause.SetClusterCordoned(context.TODO(), clusterNamespace, clusterName, clusterType,
+			nil, utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+		for _, cs := range []*configv1beta1.ClusterSummary{clusterSummary1, clusterSummary2} {
+			annotations := getAnnotations(cs)
+			Expect(annotations).ToNot(HaveKey(clusterv1.PausedAnnotation))
+			Expect(annotations).ToNot(HaveKey(pause.CordonedByAnnotation))
+		}
+		currentCluster = getCluster()
+		Expect(currentCluster.Spec.Paused).To(BeFalse())
+		Expect(currentCluster.Annotations).ToNot(HaveKey(pause.CordonedByAnnotation))
+		Expect(currentCluster.Annotations).ToNot(HaveKey(pause.PausedByCordonAnnotation))
+	})
+
+	It("setClusterCordoned does not resume a cluster paused before being cordoned", func() {
+		currentCluster := getCluster()
+		currentCluster.Spec.Paused = true
+		Expect(c.Update(context.TODO(), currentCluster)).To(Succeed())
+
+		Expect(pause.SetClusterCordoned(context.TODO(), clusterNamespace, clusterName, clusterType,
+			pause.NewCordonInfo("alice", "INC-42"), utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+		currentCluster = getCluster()
+		Expect(currentCluster.Annotations).To(HaveKeyWithValue(pause.CordonedByAnnotation, "alice"))
+		Expect(currentCluster.Annotations).ToNot(HaveKey(pause.PausedByCordonAnnotation))
+
+		Expect(pause.SetClusterCordoned(context.TODO(), clusterNamespace, clusterName, clusterType,
+			nil, utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+		currentCluster = getCluster()
+		Expect(currentCluster.Spec.Paused).To(BeTrue())
+		Expect(currentCluster.Annotations).ToNot(HaveKey(pause.CordonedByAnnotation))
+		Expect(getAnnotations(clusterSummary1)).ToNot(HaveKey(clusterv1.PausedAnnotation))
+	})
+
+	It("setClusterCordoned does not resume ClusterSummaries not paused by cordon", func() {
+		currentClusterSummary := &configv1beta1.ClusterSummary{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: clusterSummary1.Namespace,
+			Name: clusterSummary1.Name}, currentClusterSummary)).To(Succeed())
+		currentClusterSummary.Annotations = map[string]string{clusterv1.PausedAnnotation: "true"}
+		Expect(c.Update(context.TODO(), currentClusterSummary)).To(Succeed())
+
+		var out bytes.Buffer
+		Expect(pause.SetClusterCordoned(context.TODO(), clusterNamespace, clusterName, clusterType,
+			nil, utils.DryRunNone, true, nil, &out, logger)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("not cordoned"))
+		Expect(getAnnotations(clusterSummary1)).To(HaveKey(clusterv1.PausedAnnotation))
+	})
+})
@@ -0,0 +1,303 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+// pausedChange is an object whose paused annotation must be added or removed
+type pausedChange struct {
+	object     client.Object
+	annotation string
+}
+
+// setProfilePaused pauses (or resumes) a ClusterProfile/Profile and all the ClusterSummary
+// instances it owns. Affected clusters and objects to annotate are printed on out and, unless
+// yes is set or in dry-run mode, user is asked for confirmation on in.
+func setProfilePaused(ctx context.Context, kind, namespace, name string, paused bool,
+	dryRun utils.DryRunMode, yes bool, in io.Reader, out io.Writer, logger logr.Logger) error {
+
+	instance := utils.GetAccessInstance()
+
+	profile, err := instance.GetProfileInstance(ctx, kind, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	profileInfo := describeProfile(profile)
+	clusters := utils.GetMatchingClusters(profile.Status.MatchingClusterRefs)
+	fmt.Fprintf(out, "Clusters matching %s: %d\n", profileInfo, len(clusters))
+	for i := range clusters {
+		fmt.Fprintf(out, "  %s\n", clusters[i])
+	}
+
+	clusterSummaries, err := getProfileClusterSummaries(ctx, profile, logger)
+	if err != nil {
+		return err
+	}
+
+	toChange := getPausedChanges(profile, clusterSummaries, paused)
+	if len(toChange) == 0 {
+		state := "paused"
+		if !paused {
+			state = "not paused"
+		}
+		fmt.Fprintf(out, "%s is already %s\n", profileInfo, state)
+		return nil
+	}
+
+	changes := make([]utils.Change, len(toChange))
+	for i := range toChange {
+		changes[i] = getChange(toChange[i], paused)
+	}
+
+	apply, err := utils.ConfirmChanges(in, out, changes, dryRun, yes)
+	if err != nil || !apply {
+		return err
+	}
+
+	opts := []client.PatchOption{}
+	if dryRun == utils.DryRunServer {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	// Annotate the profile first, so no new ClusterSummary changes are propagated while
+	// ClusterSummaries are being paused. On resume, the profile is annotated last.
+	c := instance.GetClient()
+	for i := range toChange {
+		index := i
+		if !paused {
+			index = len(toChange) - 1 - i
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("patching %s", changes[index].Object))
+		if err := patchPausedAnnotation(ctx, c, toChange[index], paused, opts...); err != nil {
+			return fmt.Errorf("failed to patch %s: %w", changes[index].Object, err)
+		}
+	}
+
+	if dryRun == utils.DryRunServer {
+		fmt.Fprintf(out, "Dry run (server): changes to %s validated\n", profileInfo)
+		return nil
+	}
+
+	action := "paused"
+	if !paused {
+		action = "resumed"
+	}
+	fmt.Fprintf(out, "%s %s\n", profileInfo, action)
+	return nil
+}
+
+// getProfileClusterSummaries returns the ClusterSummary instances owned by the ClusterProfile/Profile
+func getProfileClusterSummaries(ctx context.Context, profile *utils.ProfileInstance,
+	logger logr.Logger) ([]*configv1beta1.ClusterSummary, error) {
+
+	clusterSummaries, err := utils.GetAccessInstance().ListClusterSummaries(ctx, profile.Namespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*configv1beta1.ClusterSummary, 0)
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		owner, err := configv1beta1.GetProfileOwnerReference(cs)
+		if err != nil || owner == nil || owner.Kind != profile.Kind || owner.Name != profile.Name {
+			continue
+		}
+		result = append(result, cs)
+	}
+
+	return result, nil
+}
+
+// getPausedChanges returns the objects whose paused annotation must be added (paused is true)
+// or removed (paused is false). The ClusterProfile/Profile comes first.
+// On resume, ClusterSummaries in a cordoned cluster are skipped: those stay paused till the
+// cluster is uncordoned.
+func getPausedChanges(profile *utils.ProfileInstance, clusterSummaries []*configv1beta1.ClusterSummary,
+	paused bool) []pausedChange {
+
+	result := make([]pausedChange, 0)
+	if utils.IsProfilePaused(profile.Object) != paused {
+		result = append(result, pausedChange{object: profile.Object,
+			annotation: configv1beta1.ProfilePausedAnnotation})
+	}
+
+	for i := range clusterSummaries {
+		if _, cordoned := clusterSummaries[i].Annotations[CordonedByAnnotation]; cordoned && !paused {
+			continue
+		}
+		_, ok := clusterSummaries[i].Annotations[clusterv1.PausedAnnotation]
+		if ok != paused {
+			result = append(result, pausedChange{object: clusterSummaries[i],
+				annotation: clusterv1.PausedAnnotation})
+		}
+	}
+
+	return result
+}
+
+func getChange(change pausedChange, paused bool) utils.Change {
+	details := fmt.Sprintf("add annotation %s", change.annotation)
+	if !paused {
+		details = fmt.Sprintf("remove annotation %s", change.annotation)
+	}
+
+	kind := change.object.GetObjectKind().GroupVersionKind().Kind
+	switch change.object.(type) {
+	case *configv1beta1.ClusterProfile:
+		kind = configv1beta1.ClusterProfileKind
+	case *configv1beta1.Profile:
+		kind = configv1beta1.ProfileKind
+	case *configv1beta1.ClusterSummary:
+		kind = configv1beta1.ClusterSummaryKind
+	}
+
+	object := fmt.Sprintf("%s/%s", kind, change.object.GetName())
+	if change.object.GetNamespace() != "" {
+		object = fmt.Sprintf("%s/%s/%s", kind, change.object.GetNamespace(), change.object.GetName())
+	}
+
+	return utils.Change{Action: "patch", Object: object, Details: details}
+}
+
+func patchPausedAnnotation(ctx context.Context, c client.Client, change pausedChange, paused bool,
+	opts ...client.PatchOption) error {
+
+	obj := change.object
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+
+	annotations := obj.GetAnnotations()
+	if paused {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[change.annotation] = "true"
+	} else {
+		delete(annotations, change.annotation)
+	}
+	obj.SetAnnotations(annotations)
+
+	return c.Patch(ctx, obj, patch, opts...)
+}
+
+func describeProfile(profile *utils.ProfileInstance) string {
+	if profile.Namespace != "" {
+		return fmt.Sprintf("%s/%s/%s", profile.Kind, profile.Namespace, profile.Name)
+	}
+	return fmt.Sprintf("%s/%s", profile.Kind, profile.Name)
+}
+
+func runProfileCommand(ctx context.Context, doc string, args []string, paused bool, logger logr.Logger) error {
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	kind, namespace, name, err := utils.ParseProfileReference(parsedArgs["<kind/name>"].(string))
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := utils.ParseDryRun(parsedArgs["--dry-run"])
+	if err != nil {
+		return err
+	}
+
+	return setProfilePaused(ctx, kind, namespace, name, paused, dryRun, parsedArgs["--yes"].(bool),
+		os.Stdin, os.Stdout, logger)
+}
+
+// Profile pauses a ClusterProfile/Profile and the ClusterSummary instances it owns
+func Profile(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl pause profile [options] <kind/name> [--dry-run=<mode>] [--yes] [--verbose]
+
+     <kind/name>        ClusterProfile/<name> or Profile/<namespace>/<name> to pause.
+     --dry-run=<mode>   Print the objects to annotate without annotating them.
+                        Accepted values: client (only print), server (also submit the changes to the API
+                        server in dry-run mode) and none.
+     --yes              Pause without asking for confirmation.
+
+Options:
+  -h --help                Show this screen.
+     --verbose             Verbose mode. Print each step.
+
+Description:
+  The pause profile command freezes the rollout of a ClusterProfile/Profile. It annotates the
+  ClusterProfile/Profile and all the ClusterSummary instances it owns, so Sveltos neither
+  propagates profile changes nor reconciles add-ons in the matching clusters.
+  Matching clusters are printed before anything is changed.
+`
+	return runProfileCommand(ctx, doc, args, true, logger)
+}
+
+// ResumeProfile resumes a ClusterProfile/Profile and the ClusterSummary instances it owns
+func ResumeProfile(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl resume profile [options] <kind/name> [--dry-run=<mode>] [--yes] [--verbose]
+
+     <kind/name>        ClusterProfile/<name> or Profile/<namespace>/<name> to resume.
+     --dry-run=<mode>   Print the objects to annotate without annotating them.
+                        Accepted values: client (only print), server (also submit the changes to the API
+                        server in dry-run mode) and none.
+     --yes              Resume without asking for confirmation.
+
+Options:
+  -h --help                Show this screen.
+     --verbose             Verbose mode. Print each step.
+
+Description:
+  The resume profile command removes the paused annotation from a ClusterProfile/Profile and
+  from all the ClusterSummary instances it owns, so Sveltos resumes deploying add-ons in the
+  matching clusters. ClusterSummary instances of a cordoned cluster stay paused till the
+  cluster is uncordoned. Matching clusters are printed before anything is changed.
+`
+	return runProfileCommand(ctx, doc, args, false, logger)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2/textlogger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/pause"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Pause profile", func() {
+	var logger logr.Logger
+	var clusterProfile *configv1beta1.ClusterProfile
+	var clusterSummary *configv1beta1.ClusterSummary
+	var otherClusterSummary *configv1beta1.ClusterSummary
+	var c client.Client
+
+	BeforeEach(func() {
+		logger = textlogger.NewLogger(textlogger.NewConfig())
+
+		clusterNamespace := randomString()
+		clusterName := randomString()
+
+		clusterProfile = &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Status: configv1beta1.Status{
+				MatchingClusterRefs: []corev1.ObjectReference{
+					{
+						Kind:       libsveltosv1beta1.SveltosClusterKind,
+						APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace:  clusterNamespace,
+						Name:       clusterName,
+					},
+				},
+			},
+		}
+
+		clusterSummary = &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: configv1beta1.GroupVersion.String(),
+						Kind:       configv1beta1.ClusterProfileKind,
+						Name:       clusterProfile.Name,
+						UID:        "123",
+					},
+				},
+			},
+		}
+
+		otherClusterSummary = &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: configv1beta1.GroupVersion.String(),
+						Kind:       configv1beta1.ClusterProfileKind,
+						Name:       randomString(),
+						UID:        "456",
+					},
+				},
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c = fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(clusterProfile, clusterSummary, otherClusterSummary).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	})
+
+	isPaused := func() (profilePaused, clusterSummaryPaused, otherPaused bool) {
+		currentClusterProfile := &configv1beta1.ClusterProfile{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: clusterProfile.Name},
+			currentClusterProfile)).To(Succeed())
+
+		currentClusterSummary := &configv1beta1.ClusterSummary{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: clusterSummary.Namespace,
+			Name: clusterSummary.Name}, currentClusterSummary)).To(Succeed())
+		_, clusterSummaryPaused = currentClusterSummary.Annotations[clusterv1.PausedAnnotation]
+
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: otherClusterSummary.Namespace,
+			Name: otherClusterSummary.Name}, currentClusterSummary)).To(Succeed())
+		_, otherPaused = currentClusterSummary.Annotations[clusterv1.PausedAnnotation]
+
+		return utils.IsProfilePaused(currentClusterProfile), clusterSummaryPaused, otherPaused
+	}
+
+	It("setProfilePaused pauses and resumes profile and its ClusterSummaries", func() {
+		var out bytes.Buffer
+		Expect(pause.SetProfilePaused(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			true, utils.DryRunNone, true, nil, &out, logger)).To(Succeed())
+		// Affected clusters are printed
+		ref := &clusterProfile.Status.MatchingClusterRefs[0]
+		Expect(out.String()).To(ContainSubstring(ref.Namespace + "/" + ref.Name))
+
+		profilePaused, clusterSummaryPaused, otherPaused := isPaused()
+		Expect(profilePaused).To(BeTrue())
+		Expect(clusterSummaryPaused).To(BeTrue())
+		// ClusterSummaries owned by other profiles are not paused
+		Expect(otherPaused).To(BeFalse())
+
+		out.Reset()
+		Expect(pause.SetProfilePaused(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			true, utils.DryRunNone, true, nil, &out, logger)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("already paused"))
+
+		Expect(pause.SetProfilePaused(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			false, utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+
+		profilePaused, clusterSummaryPaused, _ = isPaused()
+		Expect(profilePaused).To(BeFalse())
+		Expect(clusterSummaryPaused).To(BeFalse())
+	})
+
+	It("setProfilePaused does not resume ClusterSummaries in a cordoned cluster", func() {
+		Expect(pause.SetProfilePaused(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			true, utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+
+		// Cordon cluster annotates the ClusterSummary
+		currentClusterSummary := &configv1beta1.ClusterSummary{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: clusterSummary.Namespace,
+			Name: clusterSummary.Name}, currentClusterSummary)).To(Succeed())
+		currentClusterSummary.Annotations[pause.CordonedByAnnotation] = randomString()
+		currentClusterSummary.Annotations[pause.CordonReasonAnnotation] = randomString()
+		Expect(c.Update(context.TODO(), currentClusterSummary)).To(Succeed())
+
+		Expect(pause.SetProfilePaused(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			false, utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+
+		profilePaused, clusterSummaryPaused, _ := isPaused()
+		Expect(profilePaused).To(BeFalse())
+		// ClusterSummary stays paused and cordoned
+		Expect(clusterSummaryPaused).To(BeTrue())
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: clusterSummary.Namespace,
+			Name: clusterSummary.Name}, currentClusterSummary)).To(Succeed())
+		Expect(currentClusterSummary.Annotations).To(HaveKey(pause.CordonedByAnnotation))
+		Expect(currentClusterSummary.Annotations).To(HaveKey(pause.CordonReasonAnnotation))
+	})
+
+	It("setProfilePaused does not pause in dry-run mode or when not confirmed", func() {
+		for _, dryRun := range []utils.DryRunMode{utils.DryRunClient, utils.DryRunServer} {
+			var out bytes.Buffer
+			Expect(pause.SetProfilePaused(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+				true, dryRun, false, nil, &out, logger)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("ClusterSummary/" + clusterSummary.Namespace + "/" +
+				clusterSummary.Name))
+			Expect(out.String()).To(ContainSubstring(configv1beta1.ProfilePausedAnnotation))
+
+			profilePaused, clusterSummaryPaused, _ := isPaused()
+			Expect(profilePaused).To(BeFalse())
+			Expect(clusterSummaryPaused).To(BeFalse())
+		}
+
+		err := pause.SetProfilePaused(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			true, utils.DryRunNone, false, strings.NewReader("n\n"), io.Discard, logger)
+		Expect(err).To(MatchError(utils.ErrAborted))
+	})
+})
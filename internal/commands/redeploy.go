@@ -43,6 +43,8 @@ func RedeployCluster(ctx context.Context, args []string, logger logr.Logger) err
 	sveltosctl redeploy <command> [<args>...]
 
 	cluster       Force a full re-evaluation and redeployment of add-ons and resources on a cluster.
+	clusters      Force a full re-evaluation and redeployment of add-ons and resources on many clusters,
+	              selected by profile, label selector and/or namespace.
 
 Options:
 	-h --help      Show this screen.
@@ -75,6 +77,8 @@ Description:
 	switch command {
 	case clusterCommand:
 		return redeploy.ForceDeployment(ctx, arguments, logger)
+	case "clusters":
+		return redeploy.ForceDeploymentInClusters(ctx, arguments, logger)
 	default:
 		//nolint: forbidigo // print doc
 		fmt.Println(doc)
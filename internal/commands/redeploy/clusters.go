@@ -0,0 +1,300 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redeploy
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	defaultConcurrency = 5
+)
+
+// targetCluster is a cluster to redeploy add-ons to
+type targetCluster struct {
+	namespace   string
+	name        string
+	clusterType libsveltosv1beta1.ClusterType
+}
+
+func (t *targetCluster) String() string {
+	return fmt.Sprintf("%s:%s/%s", t.clusterType, t.namespace, t.name)
+}
+
+// clusterReset contains the ClusterSummary instances to reset for a cluster, in reset order
+type clusterReset struct {
+	cluster    targetCluster
+	resetOrder []string
+	csMap      map[string]*configv1beta1.ClusterSummary
+}
+
+// getTargetClusters returns the clusters in namespace (all namespaces if empty) matching selector.
+// If profile kind is set, only clusters matching the ClusterProfile/Profile are returned.
+func getTargetClusters(ctx context.Context, kind, profileNamespace, profileName, namespace string,
+	selector labels.Selector) ([]targetCluster, error) {
+
+	instance := utils.GetAccessInstance()
+
+	var matching map[targetCluster]bool
+	if kind != "" {
+		profile, err := instance.GetProfileInstance(ctx, kind, profileNamespace, profileName)
+		if err != nil {
+			return nil, err
+		}
+		matching = make(map[targetCluster]bool)
+		for i := range profile.Status.MatchingClusterRefs {
+			ref := &profile.Status.MatchingClusterRefs[i]
+			matching[targetCluster{namespace: ref.Namespace, name: ref.Name,
+				clusterType: clusterproxy.GetClusterType(ref)}] = true
+		}
+	}
+
+	clusters, err := instance.ListManagedClusters(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]targetCluster, 0)
+	for i := range clusters {
+		cluster := clusters[i]
+		if !selector.Matches(labels.Set(cluster.GetLabels())) {
+			continue
+		}
+		target := targetCluster{namespace: cluster.GetNamespace(), name: cluster.GetName(),
+			clusterType: libsveltosv1beta1.ClusterTypeCapi}
+		if cluster.GetObjectKind().GroupVersionKind().Kind == libsveltosv1beta1.SveltosClusterKind {
+			target.clusterType = libsveltosv1beta1.ClusterTypeSveltos
+		}
+		if matching != nil && !matching[target] {
+			continue
+		}
+		targets = append(targets, target)
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].String() < targets[j].String()
+	})
+
+	return targets, nil
+}
+
+// redeployClusters resets, in dependency order, the ClusterSummary instances of each cluster.
+// ClusterSummary instances to reset are printed on out and, unless yes is set or in dry-run mode,
+// user is asked for confirmation on in. At most concurrency clusters are reset at a time and
+// progress is reported on out. An error is returned if at least one cluster failed.
+func redeployClusters(ctx context.Context, targets []targetCluster, concurrency int,
+	dryRun utils.DryRunMode, yes bool, in io.Reader, out io.Writer, logger logr.Logger) error {
+
+	c := utils.GetAccessInstance().GetClient()
+
+	resets := make([]clusterReset, 0, len(targets))
+	changes := make([]utils.Change, 0)
+	for i := range targets {
+		target := targets[i]
+		resetOrder, csMap, err := GetClusterSummariesInOrder(ctx, c, target.namespace, target.name,
+			&target.clusterType)
+		if err != nil {
+			return fmt.Errorf("cluster %s: %w", target.String(), err)
+		}
+		if len(resetOrder) == 0 {
+			logger.V(logs.LogDebug).Info(fmt.Sprintf("no ClusterSummary for cluster %s", target.String()))
+			continue
+		}
+		resets = append(resets, clusterReset{cluster: target, resetOrder: resetOrder, csMap: csMap})
+		changes = append(changes, getResetChanges(target.namespace, resetOrder)...)
+	}
+
+	apply, err := utils.ConfirmChanges(in, out, changes, dryRun, yes)
+	if err != nil || !apply {
+		return err
+	}
+
+	opts := []client.SubResourcePatchOption{}
+	if dryRun == utils.DryRunServer {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	var mu sync.Mutex
+	completed, failed := 0, 0
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for i := range resets {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(reset *clusterReset) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			l := logger.WithValues("cluster", reset.cluster.String())
+			err := performStatusReset(ctx, c, reset.resetOrder, reset.csMap, l, opts...)
+
+			mu.Lock()
+			defer mu.Unlock()
+			completed++
+			if err != nil {
+				failed++
+				fmt.Fprintf(out, "[%d/%d] %s: failed: %v\n", completed, len(resets), reset.cluster.String(), err)
+				return
+			}
+			fmt.Fprintf(out, "[%d/%d] %s: reset %d ClusterSummary instance(s)\n", completed, len(resets),
+				reset.cluster.String(), len(reset.resetOrder))
+		}(&resets[i])
+	}
+	wg.Wait()
+
+	if failed != 0 {
+		return fmt.Errorf("%d out of %d clusters failed to redeploy", failed, len(resets))
+	}
+	return nil
+}
+
+// getResetChanges returns the changes resetting ClusterSummary instances in resetOrder
+func getResetChanges(namespace string, resetOrder []string) []utils.Change {
+	changes := make([]utils.Change, len(resetOrder))
+	for i, csName := range resetOrder {
+		changes[i] = utils.Change{
+			Action:  "patch",
+			Object:  fmt.Sprintf("ClusterSummary/%s/%s", namespace, csName),
+			Details: fmt.Sprintf("reset status (step %d of %d)", i+1, len(resetOrder)),
+		}
+	}
+	return changes
+}
+
+// ForceDeploymentInClusters forces a redeployment of all add-ons in every cluster matching
+// a ClusterProfile/Profile, a label selector and/or a namespace.
+func ForceDeploymentInClusters(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl redeploy clusters [options] [--profile=<kind/name>] [--namespace=<name>] [--selector=<labels>]
+                               [--concurrency=<value>] [--dry-run=<mode>] [--yes] [--verbose]
+
+     --profile=<kind/name>   Redeploy clusters matching ClusterProfile/<name> or Profile/<namespace>/<name>.
+     --namespace=<name>      Redeploy clusters in this namespace.
+     --selector=<labels>     Redeploy clusters matching this label selector (for instance env=prod,tier!=edge).
+     --concurrency=<value>   Number of clusters redeployed in parallel. Default: 5.
+     --dry-run=<mode>        Print the ClusterSummary instances to reset, in reset order, without resetting them.
+                             Accepted values: client (only print), server (also submit the changes to the API
+                             server in dry-run mode) and none.
+     --yes                   Reset the ClusterSummary instances without asking for confirmation.
+
+Options:
+  -h --help                Show this screen.
+     --verbose             Verbose mode. Print each step.
+
+Description:
+  The 'sveltosctl redeploy clusters' command forces Sveltos to re-apply all configured
+  add-ons and resources in many clusters. At least one of --profile, --namespace and
+  --selector must be specified; when more are, clusters must match all of them.
+  As with 'sveltosctl redeploy cluster', the ClusterSummary instances of each cluster
+  are reset in dependency order.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	if parsedArgs["--profile"] == nil && parsedArgs["--namespace"] == nil && parsedArgs["--selector"] == nil {
+		return errors.New("at least one of --profile, --namespace and --selector must be specified")
+	}
+
+	var kind, profileNamespace, profileName string
+	if passedProfile := parsedArgs["--profile"]; passedProfile != nil {
+		kind, profileNamespace, profileName, err = utils.ParseProfileReference(passedProfile.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	namespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		namespace = passedNamespace.(string)
+	}
+
+	selector := labels.Everything()
+	if passedSelector := parsedArgs["--selector"]; passedSelector != nil {
+		selector, err = labels.Parse(passedSelector.(string))
+		if err != nil {
+			return fmt.Errorf("invalid selector: %w", err)
+		}
+	}
+
+	concurrency := defaultConcurrency
+	if passedConcurrency := parsedArgs["--concurrency"]; passedConcurrency != nil {
+		concurrency, err = strconv.Atoi(passedConcurrency.(string))
+		if err != nil || concurrency <= 0 {
+			return errors.New("concurrency must be a positive integer")
+		}
+	}
+
+	dryRun, err := utils.ParseDryRun(parsedArgs["--dry-run"])
+	if err != nil {
+		return err
+	}
+
+	targets, err := getTargetClusters(ctx, kind, profileNamespace, profileName, namespace, selector)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		//nolint: forbidigo // print info message
+		fmt.Println("No cluster matches.")
+		return nil
+	}
+
+	return redeployClusters(ctx, targets, concurrency, dryRun, parsedArgs["--yes"].(bool),
+		os.Stdin, os.Stdout, logger)
+}
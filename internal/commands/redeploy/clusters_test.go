@@ -0,0 +1,178 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redeploy_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/redeploy"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Redeploy clusters", func() {
+	var logger logr.Logger
+	var namespace string
+	var clusters []*libsveltosv1beta1.SveltosCluster
+	var clusterSummaries []*configv1beta1.ClusterSummary
+	var c client.Client
+
+	BeforeEach(func() {
+		logger = textlogger.NewLogger(textlogger.NewConfig())
+		namespace = randomString()
+
+		initObjects := []client.Object{}
+		clusters = make([]*libsveltosv1beta1.SveltosCluster, 3)
+		clusterSummaries = make([]*configv1beta1.ClusterSummary, 3)
+		for i := range clusters {
+			env := "prod"
+			if i == 2 {
+				env = "test"
+			}
+			clusters[i] = &libsveltosv1beta1.SveltosCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      fmt.Sprintf("cluster-%d", i),
+					Labels:    map[string]string{"env": env},
+				},
+			}
+			clusterSummaries[i] = &configv1beta1.ClusterSummary{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      randomString(),
+					Labels: map[string]string{
+						configv1beta1.ClusterNameLabel: clusters[i].Name,
+						configv1beta1.ClusterTypeLabel: string(libsveltosv1beta1.ClusterTypeSveltos),
+					},
+				},
+				Status: configv1beta1.ClusterSummaryStatus{
+					FeatureSummaries: []configv1beta1.FeatureSummary{
+						{
+							FeatureID: libsveltosv1beta1.FeatureHelm,
+							Status:    libsveltosv1beta1.FeatureStatusProvisioned,
+							Hash:      []byte(randomString()),
+						},
+					},
+				},
+			}
+			initObjects = append(initObjects, clusters[i], clusterSummaries[i])
+		}
+
+		clusterProfile := &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Status: configv1beta1.Status{
+				MatchingClusterRefs: []corev1.ObjectReference{
+					{
+						Kind: libsveltosv1beta1.SveltosClusterKind, APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace: namespace, Name: clusters[1].Name,
+					},
+					{
+						Kind: libsveltosv1beta1.SveltosClusterKind, APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace: namespace, Name: clusters[2].Name,
+					},
+				},
+			},
+		}
+		initObjects = append(initObjects, clusterProfile)
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c = fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(initObjects...).
+			WithObjects(initObjects...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	})
+
+	getFeatureSummaries := func(cs *configv1beta1.ClusterSummary) int {
+		currentClusterSummary := &configv1beta1.ClusterSummary{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: cs.Namespace, Name: cs.Name},
+			currentClusterSummary)).To(Succeed())
+		return len(currentClusterSummary.Status.FeatureSummaries)
+	}
+
+	It("getTargetClusters filters clusters by namespace, selector and profile", func() {
+		selector, err := labels.Parse("env=prod")
+		Expect(err).To(BeNil())
+
+		targets, err := redeploy.GetTargetClusters(context.TODO(), "", "", "", namespace, selector)
+		Expect(err).To(BeNil())
+		Expect(targets).To(Equal([]string{
+			fmt.Sprintf("Sveltos:%s/%s", namespace, clusters[0].Name),
+			fmt.Sprintf("Sveltos:%s/%s", namespace, clusters[1].Name),
+		}))
+
+		profileList := &configv1beta1.ClusterProfileList{}
+		Expect(c.List(context.TODO(), profileList)).To(Succeed())
+		Expect(len(profileList.Items)).To(Equal(1))
+
+		targets, err = redeploy.GetTargetClusters(context.TODO(), configv1beta1.ClusterProfileKind, "",
+			profileList.Items[0].Name, "", selector)
+		Expect(err).To(BeNil())
+		Expect(targets).To(Equal([]string{fmt.Sprintf("Sveltos:%s/%s", namespace, clusters[1].Name)}))
+	})
+
+	It("redeployClusters resets ClusterSummary Status of all selected clusters", func() {
+		selector, err := labels.Parse("env=prod")
+		Expect(err).To(BeNil())
+
+		var out bytes.Buffer
+		Expect(redeploy.RedeployClusters(context.TODO(), "", "", "", namespace, selector, 1,
+			utils.DryRunNone, true, nil, &out, logger)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("[1/2]"))
+		Expect(out.String()).To(ContainSubstring("[2/2]"))
+
+		Expect(getFeatureSummaries(clusterSummaries[0])).To(Equal(0))
+		Expect(getFeatureSummaries(clusterSummaries[1])).To(Equal(0))
+		// Cluster not matching selector is not redeployed
+		Expect(getFeatureSummaries(clusterSummaries[2])).To(Equal(1))
+	})
+
+	It("redeployClusters does not reset ClusterSummary Status in dry-run mode", func() {
+		for _, dryRun := range []utils.DryRunMode{utils.DryRunClient, utils.DryRunServer} {
+			var out bytes.Buffer
+			Expect(redeploy.RedeployClusters(context.TODO(), "", "", "", namespace, labels.Everything(), 2,
+				dryRun, false, nil, &out, logger)).To(Succeed())
+			for i := range clusterSummaries {
+				Expect(out.String()).To(ContainSubstring(
+					fmt.Sprintf("ClusterSummary/%s/%s", namespace, clusterSummaries[i].Name)))
+				Expect(getFeatureSummaries(clusterSummaries[i])).To(Equal(1))
+			}
+		}
+
+		Expect(redeploy.RedeployClusters(context.TODO(), "", "", "", namespace, labels.Everything(), 2,
+			utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
+		for i := range clusterSummaries {
+			Expect(getFeatureSummaries(clusterSummaries[i])).To(Equal(0))
+		}
+	})
+})
@@ -16,7 +16,43 @@ limitations under the License.
 
 package redeploy
 
+import (
+	"context"
+	"io"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
 var (
 	ResetClusterSummaryInstance = resetClusterSummaryInstance
-	GetClusterSummariesInOrder  = getClusterSummariesInOrder
 )
+
+// GetTargetClusters returns the clusters to redeploy, formatted as type:namespace/name
+func GetTargetClusters(ctx context.Context, kind, profileNamespace, profileName, namespace string,
+	selector labels.Selector) ([]string, error) {
+
+	targets, err := getTargetClusters(ctx, kind, profileNamespace, profileName, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, len(targets))
+	for i := range targets {
+		result[i] = targets[i].String()
+	}
+	return result, nil
+}
+
+// RedeployClusters redeploys the clusters matching profile, namespace and selector
+func RedeployClusters(ctx context.Context, kind, profileNamespace, profileName, namespace string,
+	selector labels.Selector, concurrency int, dryRun utils.DryRunMode, yes bool,
+	in io.Reader, out io.Writer, logger logr.Logger) error {
+
+	targets, err := getTargetClusters(ctx, kind, profileNamespace, profileName, namespace, selector)
+	if err != nil {
+		return err
+	}
+	return redeployClusters(ctx, targets, concurrency, dryRun, yes, in, out, logger)
+}
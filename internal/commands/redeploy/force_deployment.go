@@ -20,6 +20,8 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/docopt/docopt-go"
@@ -42,11 +44,16 @@ import (
 // checks. This action is irreversible once executed.
 func ForceDeployment(ctx context.Context, args []string, logger logr.Logger) error {
 	doc := `Usage:
-  sveltosctl redeploy cluster [options] --namespace=<name> --cluster=<name> --cluster-type=<type> [--verbose]
+  sveltosctl redeploy cluster [options] --namespace=<name> --cluster=<name> --cluster-type=<type> [--dry-run=<mode>]
+                              [--yes] [--verbose]
 
      --namespace=<name>     Specifies the namespace where the Cluster resource is located.
      --cluster=<name>       Defines the name of the target cluster to force redeployment on.
      --cluster-type=<type>  Specifies the type of cluster. Accepted values are 'Capi' and 'Sveltos'.
+     --dry-run=<mode>       Print the ClusterSummary instances to reset, in reset order, without resetting them.
+                            Accepted values: client (only print), server (also submit the changes to the API
+                            server in dry-run mode) and none.
+     --yes                  Reset the ClusterSummary instances without asking for confirmation.
 
 Options:
   -h --help                Show this screen.
@@ -112,13 +119,22 @@ Description:
 		return fmt.Errorf("both --namespace and --cluster must be specified")
 	}
 
-	return resetClusterSummaryInstance(ctx, namespace, cluster, &clusterType, logger)
+	dryRun, err := utils.ParseDryRun(parsedArgs["--dry-run"])
+	if err != nil {
+		return err
+	}
+
+	return resetClusterSummaryInstance(ctx, namespace, cluster, &clusterType, dryRun, parsedArgs["--yes"].(bool),
+		os.Stdin, os.Stdout, logger)
 }
 
 // resetClusterSummaryInstance finds all ClusterSummary resources associated with
 // the given cluster and resets their Status field to force a full redeployment.
+// ClusterSummary instances to reset are printed on out and, unless yes is set or in
+// dry-run mode, user is asked for confirmation on in.
 func resetClusterSummaryInstance(ctx context.Context, namespace, cluster string,
-	clusterType *libsveltosv1beta1.ClusterType, logger logr.Logger) error {
+	clusterType *libsveltosv1beta1.ClusterType, dryRun utils.DryRunMode, yes bool,
+	in io.Reader, out io.Writer, logger logr.Logger) error {
 
 	logger.V(logs.LogDebug).Info(
 		"Preparing to force redeployment by resetting ClusterSummary statuses",
@@ -135,7 +151,7 @@ func resetClusterSummaryInstance(ctx context.Context, namespace, cluster string,
 		return fmt.Errorf("failed to get Kubernetes client: client is not initialized")
 	}
 	// 2. Get ClusterSummaries in Dependency Order
-	resetOrder, csMap, err := getClusterSummariesInOrder(ctx, c, namespace, cluster, clusterType)
+	resetOrder, csMap, err := GetClusterSummariesInOrder(ctx, c, namespace, cluster, clusterType)
 	if err != nil {
 		return err
 	}
@@ -147,17 +163,29 @@ func resetClusterSummaryInstance(ctx context.Context, namespace, cluster string,
 
 	logger.V(logs.LogDebug).Info("ClusterSummary reset order determined", "Order", resetOrder)
 
-	// 3. Execute the Status Reset in the Determined Order
-	return performStatusReset(ctx, c, resetOrder, csMap, logger)
+	// 3. Print the changes and ask for confirmation
+	changes := getResetChanges(namespace, resetOrder)
+	apply, err := utils.ConfirmChanges(in, out, changes, dryRun, yes)
+	if err != nil || !apply {
+		return err
+	}
+
+	opts := []client.SubResourcePatchOption{}
+	if dryRun == utils.DryRunServer {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	// 4. Execute the Status Reset in the Determined Order
+	return performStatusReset(ctx, c, resetOrder, csMap, logger, opts...)
 }
 
-// getClusterSummariesInOrder lists all relevant ClusterSummary instances,
+// GetClusterSummariesInOrder lists all relevant ClusterSummary instances,
 // constructs a dependency graph based on Spec.DependsOn, performs a topological
 // sort, and returns the list of names in the required reset order along with
 // a map of the objects.
 //
 // The order is determined such that if B depends on A, A is reset before B.
-func getClusterSummariesInOrder(ctx context.Context, c client.Client, namespace, cluster string,
+func GetClusterSummariesInOrder(ctx context.Context, c client.Client, namespace, cluster string,
 	clusterType *libsveltosv1beta1.ClusterType,
 ) (resetOrder []string, csMap map[string]*configv1beta1.ClusterSummary, err error) {
 
@@ -257,7 +285,8 @@ func getClusterSummariesInOrder(ctx context.Context, c client.Client, namespace,
 // performStatusReset iterates through the ClusterSummary resources in the provided
 // order and clears their Status field via a Patch operation.
 func performStatusReset(ctx context.Context, c client.Client, resetOrder []string,
-	csMap map[string]*configv1beta1.ClusterSummary, logger logr.Logger) error {
+	csMap map[string]*configv1beta1.ClusterSummary, logger logr.Logger,
+	opts ...client.SubResourcePatchOption) error {
 
 	for _, csName := range resetOrder {
 		cs := csMap[csName]
@@ -272,7 +301,7 @@ func performStatusReset(ctx context.Context, c client.Client, resetOrder []strin
 
 		logger.V(logs.LogDebug).Info("Attempting to patch ClusterSummary status", "ClusterSummary", cs.Name)
 
-		if err := c.Status().Patch(ctx, cs, patch); err != nil {
+		if err := c.Status().Patch(ctx, cs, patch, opts...); err != nil {
 			return fmt.Errorf("failed to patch ClusterSummary %s/%s status: %w", cs.Namespace, cs.Name, err)
 		}
 
@@ -17,7 +17,11 @@ limitations under the License.
 package redeploy_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"strings"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -105,7 +109,7 @@ var _ = Describe("Redeploy cluster", func() {
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
 
 		Expect(redeploy.ResetClusterSummaryInstance(context.TODO(), clusterNamespace, clusterName,
-			&clusterType, logger)).To(Succeed())
+			&clusterType, utils.DryRunNone, true, nil, io.Discard, logger)).To(Succeed())
 
 		currentClusterSummary := &configv1beta1.ClusterSummary{}
 		Expect(c.Get(context.TODO(),
@@ -120,7 +124,7 @@ var _ = Describe("Redeploy cluster", func() {
 		Expect(len(currentClusterSummary.Status.FeatureSummaries)).To(Equal(2))
 	})
 
-	It("getClusterSummariesInOrder returns ClusterSummary in right order based on dependsOn", func() {
+	It("GetClusterSummariesInOrder returns ClusterSummary in right order based on dependsOn", func() {
 		clusterNamespace := randomString()
 		clusterName := randomString()
 		clusterType := libsveltosv1beta1.ClusterTypeSveltos
@@ -203,4 +207,56 @@ var _ = Describe("Redeploy cluster", func() {
 		Expect(resetOrder[2]).To(Equal(clusterSummary3.Name))
 		Expect(resetOrder[3]).To(Equal(clusterSummary4.Name))
 	})
+
+	It("resetClusterSummaryInstance prints the reset order and does not reset in dry-run mode", func() {
+		clusterNamespace := randomString()
+		clusterName := randomString()
+		clusterType := libsveltosv1beta1.ClusterTypeSveltos
+
+		clusterSummary := &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      randomString(),
+				Namespace: clusterNamespace,
+				Labels: map[string]string{
+					configv1beta1.ClusterNameLabel: clusterName,
+					configv1beta1.ClusterTypeLabel: string(clusterType),
+				},
+			},
+			Status: configv1beta1.ClusterSummaryStatus{
+				FeatureSummaries: []configv1beta1.FeatureSummary{
+					{
+						FeatureID: libsveltosv1beta1.FeatureResources,
+						Status:    libsveltosv1beta1.FeatureStatusProvisioned,
+						Hash:      []byte(randomString()),
+					},
+				},
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(clusterSummary).
+			WithObjects(clusterSummary).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		for _, dryRun := range []utils.DryRunMode{utils.DryRunClient, utils.DryRunServer} {
+			var out bytes.Buffer
+			Expect(redeploy.ResetClusterSummaryInstance(context.TODO(), clusterNamespace, clusterName,
+				&clusterType, dryRun, false, nil, &out, logger)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring(
+				fmt.Sprintf("ClusterSummary/%s/%s", clusterNamespace, clusterSummary.Name)))
+			Expect(out.String()).To(ContainSubstring("step 1 of 1"))
+
+			currentClusterSummary := &configv1beta1.ClusterSummary{}
+			Expect(c.Get(context.TODO(),
+				types.NamespacedName{Namespace: clusterNamespace, Name: clusterSummary.Name},
+				currentClusterSummary)).To(Succeed())
+			Expect(len(currentClusterSummary.Status.FeatureSummaries)).To(Equal(1))
+		}
+
+		// Not confirmed
+		err = redeploy.ResetClusterSummaryInstance(context.TODO(), clusterNamespace, clusterName,
+			&clusterType, utils.DryRunNone, false, strings.NewReader("no\n"), io.Discard, logger)
+		Expect(err).To(MatchError(utils.ErrAborted))
+	})
 })
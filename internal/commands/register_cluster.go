@@ -36,6 +36,7 @@ func RegisterCluster(ctx context.Context, args []string, logger logr.Logger) err
 	sveltosctl register <command> [<args>...]
 
 	cluster       Imports a non CAPI cluster to be managed by Sveltos.
+	clusters      Imports many non CAPI clusters, listed in a file or as kubeconfig contexts.
 
 Options:
 	-h --help      Show this screen.
@@ -68,6 +69,8 @@ Description:
 	switch command {
 	case "cluster":
 		return onboard.RegisterCluster(ctx, arguments, logger)
+	case "clusters":
+		return onboard.RegisterClusters(ctx, arguments, logger)
 	default:
 		//nolint: forbidigo // print doc
 		fmt.Println(doc)
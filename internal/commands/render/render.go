@@ -0,0 +1,385 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	"github.com/projectsveltos/libsveltos/lib/funcmap"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	libsveltostemplate "github.com/projectsveltos/libsveltos/lib/template"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+// profileFile contains the fields of a ClusterProfile/Profile read from file used for rendering
+type profileFile struct {
+	kind        string
+	namespace   string
+	name        string
+	annotations map[string]string
+	spec        *configv1beta1.Spec
+}
+
+// templateData is the data templates are instantiated with
+type templateData struct {
+	// Cluster is the managed cluster (SveltosCluster or CAPI Cluster)
+	Cluster map[string]interface{}
+	// MgmtResources are the resources fetched from the management cluster
+	// as per TemplateResourceRefs. Key is the TemplateResourceRef identifier
+	MgmtResources map[string]map[string]interface{}
+}
+
+// renderedPolicy is the content of a referenced ConfigMap/Secret key after template instantiation
+type renderedPolicy struct {
+	source  string
+	content string
+}
+
+// readProfileFile reads a ClusterProfile/Profile from a YAML file
+func readProfileFile(fileName string) (*profileFile, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(content, &u.Object); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+
+	switch u.GetKind() {
+	case configv1beta1.ClusterProfileKind:
+		clusterProfile := &configv1beta1.ClusterProfile{}
+		if err := yaml.Unmarshal(content, clusterProfile); err != nil {
+			return nil, err
+		}
+		return &profileFile{kind: u.GetKind(), name: clusterProfile.Name,
+			annotations: clusterProfile.Annotations, spec: &clusterProfile.Spec}, nil
+	case configv1beta1.ProfileKind:
+		profile := &configv1beta1.Profile{}
+		if err := yaml.Unmarshal(content, profile); err != nil {
+			return nil, err
+		}
+		return &profileFile{kind: u.GetKind(), namespace: profile.Namespace, name: profile.Name,
+			annotations: profile.Annotations, spec: &profile.Spec}, nil
+	default:
+		return nil, fmt.Errorf("%s must contain a %s or a %s", fileName,
+			configv1beta1.ClusterProfileKind, configv1beta1.ProfileKind)
+	}
+}
+
+// getCluster returns the managed cluster. If clusterType is empty, a SveltosCluster is
+// searched first and then a CAPI Cluster.
+func getCluster(ctx context.Context, c client.Client, clusterNamespace, clusterName string,
+	clusterType libsveltosv1beta1.ClusterType) (client.Object, libsveltosv1beta1.ClusterType, error) {
+
+	if clusterType != "" {
+		cluster, err := clusterproxy.GetCluster(ctx, c, clusterNamespace, clusterName, clusterType)
+		return cluster, clusterType, err
+	}
+
+	cluster, err := clusterproxy.GetCluster(ctx, c, clusterNamespace, clusterName, libsveltosv1beta1.ClusterTypeSveltos)
+	if err == nil {
+		return cluster, libsveltosv1beta1.ClusterTypeSveltos, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, "", err
+	}
+
+	cluster, err = clusterproxy.GetCluster(ctx, c, clusterNamespace, clusterName, libsveltosv1beta1.ClusterTypeCapi)
+	return cluster, libsveltosv1beta1.ClusterTypeCapi, err
+}
+
+// renderProfile resolves the profile PolicyRefs and instantiates templates for the cluster.
+// Rendered manifests are written to out.
+func renderProfile(ctx context.Context, out io.Writer, fileName, clusterNamespace, clusterName string,
+	clusterType libsveltosv1beta1.ClusterType, logger logr.Logger) error {
+
+	profile, err := readProfileFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	if profile.kind == configv1beta1.ProfileKind && profile.namespace != "" && profile.namespace != clusterNamespace {
+		return fmt.Errorf("a Profile can only be deployed in clusters in its namespace (%s)", profile.namespace)
+	}
+
+	c := utils.GetAccessInstance().GetClient()
+
+	cluster, clusterType, err := getCluster(ctx, c, clusterNamespace, clusterName, clusterType)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster %s/%s: %w", clusterNamespace, clusterName, err)
+	}
+
+	clusterContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cluster)
+	if err != nil {
+		return err
+	}
+
+	mgmtResources, err := collectTemplateResourceRefs(ctx, c, profile, clusterNamespace, clusterName, clusterType, logger)
+	if err != nil {
+		return err
+	}
+
+	data := &templateData{Cluster: clusterContent, MgmtResources: mgmtResources}
+
+	policies := make([]renderedPolicy, 0)
+	for i := range profile.spec.PolicyRefs {
+		rendered, err := renderPolicyRef(ctx, c, profile, &profile.spec.PolicyRefs[i],
+			clusterNamespace, clusterName, clusterType, data, logger)
+		if err != nil {
+			return err
+		}
+		policies = append(policies, rendered...)
+	}
+
+	for i := range policies {
+		_, _ = fmt.Fprintf(out, "---\n# Source: %s\n%s\n", policies[i].source, strings.TrimSpace(policies[i].content))
+	}
+
+	if len(profile.spec.HelmCharts) > 0 || len(profile.spec.KustomizationRefs) > 0 {
+		logger.V(logs.LogInfo).Info("HelmCharts and KustomizationRefs are not rendered")
+	}
+
+	return nil
+}
+
+// collectTemplateResourceRefs fetches the resources referenced by TemplateResourceRefs
+func collectTemplateResourceRefs(ctx context.Context, c client.Client, profile *profileFile,
+	clusterNamespace, clusterName string, clusterType libsveltosv1beta1.ClusterType, logger logr.Logger,
+) (map[string]map[string]interface{}, error) {
+
+	result := make(map[string]map[string]interface{}, len(profile.spec.TemplateResourceRefs))
+	for i := range profile.spec.TemplateResourceRefs {
+		ref := &profile.spec.TemplateResourceRefs[i]
+
+		namespace, err := libsveltostemplate.GetReferenceResourceNamespace(ctx, c, clusterNamespace, clusterName,
+			ref.Resource.Namespace, clusterType)
+		if err != nil {
+			return nil, err
+		}
+		if profile.kind == configv1beta1.ProfileKind {
+			namespace = clusterNamespace
+		}
+
+		name, err := libsveltostemplate.GetReferenceResourceName(ctx, c, clusterNamespace, clusterName,
+			ref.Resource.Name, clusterType)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Fetching %s %s/%s for identifier %s",
+			ref.Resource.Kind, namespace, name, ref.Identifier))
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(ref.Resource.APIVersion)
+		u.SetKind(ref.Resource.Kind)
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, u); err != nil {
+			if apierrors.IsNotFound(err) && ref.Optional {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s %s/%s: %w", ref.Resource.Kind, namespace, name, err)
+		}
+		result[ref.Identifier] = u.Object
+	}
+
+	return result, nil
+}
+
+// renderPolicyRef returns the content of a referenced ConfigMap/Secret, with templates instantiated
+func renderPolicyRef(ctx context.Context, c client.Client, profile *profileFile, ref *configv1beta1.PolicyRef,
+	clusterNamespace, clusterName string, clusterType libsveltosv1beta1.ClusterType, data *templateData,
+	logger logr.Logger) ([]renderedPolicy, error) {
+
+	if ref.Kind != string(libsveltosv1beta1.ConfigMapReferencedResourceKind) &&
+		ref.Kind != string(libsveltosv1beta1.SecretReferencedResourceKind) {
+
+		logger.V(logs.LogInfo).Info(fmt.Sprintf("PolicyRef %s %s is not rendered: only ConfigMaps and Secrets are supported",
+			ref.Kind, ref.Name))
+		return nil, nil
+	}
+
+	namespace, err := libsveltostemplate.GetReferenceResourceNamespace(ctx, c, clusterNamespace, clusterName,
+		ref.Namespace, clusterType)
+	if err != nil {
+		return nil, err
+	}
+	if profile.kind == configv1beta1.ProfileKind {
+		namespace = clusterNamespace
+	}
+
+	name, err := libsveltostemplate.GetReferenceResourceName(ctx, c, clusterNamespace, clusterName,
+		ref.Name, clusterType)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.V(logs.LogDebug).Info(fmt.Sprintf("Rendering %s %s/%s", ref.Kind, namespace, name))
+
+	var objectMeta *metav1.ObjectMeta
+	content := make(map[string]string)
+	if ref.Kind == string(libsveltosv1beta1.ConfigMapReferencedResourceKind) {
+		configMap := &corev1.ConfigMap{}
+		err = c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, configMap)
+		objectMeta = &configMap.ObjectMeta
+		for k, v := range configMap.Data {
+			content[k] = v
+		}
+	} else {
+		secret := &corev1.Secret{}
+		err = c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret)
+		objectMeta = &secret.ObjectMeta
+		for k, v := range secret.Data {
+			content[k] = string(v)
+		}
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) && ref.Optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", ref.Kind, namespace, name, err)
+	}
+
+	isTemplate := false
+	if objectMeta.Annotations != nil {
+		_, isTemplate = objectMeta.Annotations[libsveltosv1beta1.PolicyTemplateAnnotation]
+	}
+
+	keys := make([]string, 0, len(content))
+	for k := range content {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make([]renderedPolicy, len(keys))
+	for i, k := range keys {
+		result[i] = renderedPolicy{
+			source:  fmt.Sprintf("%s %s/%s (key: %s)", ref.Kind, namespace, name, k),
+			content: content[k],
+		}
+		if isTemplate {
+			result[i].content, err = instantiateTemplate(result[i].source, content[k], profile.annotations, data)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func instantiateTemplate(templateName, rawTemplate string, profileAnnotations map[string]string,
+	data *templateData) (string, error) {
+
+	funcMap := funcmap.SveltosFuncMap(funcmap.HasTextTemplateAnnotation(profileAnnotations))
+	funcMap["getResource"] = func(identifier string) map[string]interface{} {
+		return data.MgmtResources[identifier]
+	}
+
+	tmpl, err := template.New(templateName).Option("missingkey=error").Funcs(funcMap).Parse(rawTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template %s: %w", templateName, err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return "", fmt.Errorf("failed to instantiate template %s: %w", templateName, err)
+	}
+
+	return buffer.String(), nil
+}
+
+// Render instantiates a ClusterProfile/Profile read from file against a cluster and prints
+// resulting manifests
+func Render(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl render [options] --profile-file=<file> --cluster=<namespace/name> [--cluster-type=<type>] [--verbose]
+
+     --profile-file=<file>        File containing the ClusterProfile/Profile to render.
+     --cluster=<namespace/name>   Cluster the ClusterProfile/Profile is rendered for.
+     --cluster-type=<type>        Type of the cluster: sveltos or capi. If not specified, a SveltosCluster
+                                  is searched first and then a CAPI Cluster.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The render command resolves the ConfigMaps/Secrets referenced in PolicyRefs from the management cluster,
+  instantiates the ones which are templates using the cluster and TemplateResourceRefs, and prints the
+  resulting manifests. Nothing is created or modified, so changes can be reviewed before committing them.
+  HelmCharts, KustomizationRefs and Flux sources are not rendered.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	clusterNamespace, clusterName, err := utils.ParseClusterNamespaceName(parsedArgs["--cluster"].(string))
+	if err != nil {
+		return err
+	}
+
+	var clusterType libsveltosv1beta1.ClusterType
+	if passedClusterType := parsedArgs["--cluster-type"]; passedClusterType != nil {
+		clusterType, err = utils.ParseClusterType(passedClusterType.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return renderProfile(ctx, os.Stdout, parsedArgs["--profile-file"].(string), clusterNamespace, clusterName,
+		clusterType, logger)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/render"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	templatedPolicy = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Cluster.metadata.name }}-info
+  namespace: default
+data:
+  region: {{ index .Cluster.metadata.labels "region" }}
+  replicas: "{{ (getResource "settings").data.replicas }}"`
+
+	plainPolicy = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: {{ not-a-template }}`
+)
+
+var _ = Describe("Render", func() {
+	var cluster *libsveltosv1beta1.SveltosCluster
+	var settings *corev1.ConfigMap
+	var templated *corev1.ConfigMap
+	var plain *corev1.Secret
+	var clusterProfile *configv1beta1.ClusterProfile
+
+	BeforeEach(func() {
+		cluster = &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+				Labels:    map[string]string{"region": "us-west"},
+			},
+		}
+
+		settings = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cluster.Namespace,
+				Name:      randomString(),
+			},
+			Data: map[string]string{"replicas": "3"},
+		}
+
+		templated = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   randomString(),
+				Name:        randomString(),
+				Annotations: map[string]string{libsveltosv1beta1.PolicyTemplateAnnotation: "ok"},
+			},
+			Data: map[string]string{"policy.yaml": templatedPolicy},
+		}
+
+		plain = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cluster.Namespace,
+				Name:      randomString(),
+			},
+			Type: libsveltosv1beta1.ClusterProfileSecretType,
+			Data: map[string][]byte{"namespace.yaml": []byte(plainPolicy)},
+		}
+
+		clusterProfile = &configv1beta1.ClusterProfile{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       configv1beta1.ClusterProfileKind,
+				APIVersion: configv1beta1.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: configv1beta1.Spec{
+				TemplateResourceRefs: []configv1beta1.TemplateResourceRef{
+					{
+						Resource: corev1.ObjectReference{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Name:       settings.Name,
+						},
+						Identifier: "settings",
+					},
+				},
+				PolicyRefs: []configv1beta1.PolicyRef{
+					{
+						Kind:      string(libsveltosv1beta1.ConfigMapReferencedResourceKind),
+						Namespace: templated.Namespace,
+						Name:      templated.Name,
+					},
+					{
+						// Namespace not set: cluster namespace is used
+						Kind: string(libsveltosv1beta1.SecretReferencedResourceKind),
+						Name: plain.Name,
+					},
+					{
+						Kind:     string(libsveltosv1beta1.ConfigMapReferencedResourceKind),
+						Name:     randomString(),
+						Optional: true,
+					},
+				},
+			},
+		}
+	})
+
+	It("renderProfile resolves PolicyRefs and instantiates templates", func() {
+		initObjects := []client.Object{cluster, settings, templated, plain}
+		initializeAccess(initObjects...)
+
+		var out bytes.Buffer
+		err := render.RenderProfile(context.TODO(), &out, writeProfileFile(clusterProfile),
+			cluster.Namespace, cluster.Name, "", textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		Expect(out.String()).To(ContainSubstring(fmt.Sprintf("name: %s-info", cluster.Name)))
+		Expect(out.String()).To(ContainSubstring("region: us-west"))
+		Expect(out.String()).To(ContainSubstring(`replicas: "3"`))
+		Expect(out.String()).To(ContainSubstring(fmt.Sprintf("# Source: ConfigMap %s/%s (key: policy.yaml)",
+			templated.Namespace, templated.Name)))
+		// Content not marked as template is left untouched
+		Expect(out.String()).To(ContainSubstring("name: {{ not-a-template }}"))
+		Expect(out.String()).To(ContainSubstring(fmt.Sprintf("# Source: Secret %s/%s (key: namespace.yaml)",
+			cluster.Namespace, plain.Name)))
+	})
+
+	It("renderProfile fails when a non optional PolicyRef does not exist", func() {
+		initObjects := []client.Object{cluster, settings, templated}
+		initializeAccess(initObjects...)
+
+		var out bytes.Buffer
+		err := render.RenderProfile(context.TODO(), &out, writeProfileFile(clusterProfile),
+			cluster.Namespace, cluster.Name, "", textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("renderProfile fails when cluster does not exist", func() {
+		initializeAccess(settings, templated, plain)
+
+		var out bytes.Buffer
+		err := render.RenderProfile(context.TODO(), &out, writeProfileFile(clusterProfile),
+			cluster.Namespace, cluster.Name, libsveltosv1beta1.ClusterTypeSveltos,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+func initializeAccess(objects ...client.Object) {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+}
+
+func writeProfileFile(clusterProfile *configv1beta1.ClusterProfile) string {
+	content, err := yaml.Marshal(clusterProfile)
+	Expect(err).To(BeNil())
+
+	fileName := filepath.Join(GinkgoT().TempDir(), "profile.yaml")
+	Expect(os.WriteFile(fileName, content, 0600)).To(Succeed())
+	return fileName
+}
@@ -0,0 +1,378 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rotate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	resultRotated = "rotated"
+	resultSkipped = "skipped"
+	resultFailed  = "failed"
+
+	never = "never"
+)
+
+var (
+	genRotateHeader = func() []string {
+		return []string{"CLUSTER", "EXPIRATION", "RESULT", "NEW EXPIRATION", "MESSAGE"}
+	}
+
+	// getClientset returns the clientset used to mint tokens in a managed cluster
+	getClientset = func(restConfig *rest.Config) (kubernetes.Interface, error) {
+		return kubernetes.NewForConfig(restConfig)
+	}
+)
+
+// storedKubeconfig is the kubeconfig stored in the management cluster for a SveltosCluster
+type storedKubeconfig struct {
+	secret *corev1.Secret
+	key    string
+	config *clientcmdapi.Config
+	// authInfo is the user of the current context
+	authInfo *clientcmdapi.AuthInfo
+	claims   *utils.TokenClaims
+}
+
+// rotationResult is the outcome of the rotation of a SveltosCluster kubeconfig
+type rotationResult struct {
+	cluster       string
+	expiration    string
+	result        string
+	newExpiration string
+	message       string
+}
+
+// getStoredKubeconfig returns the kubeconfig stored for a SveltosCluster along with the
+// claims of the token it contains (nil if the token does not expire)
+func getStoredKubeconfig(ctx context.Context, cluster *libsveltosv1beta1.SveltosCluster,
+	logger logr.Logger) (*storedKubeconfig, error) {
+
+	instance := utils.GetAccessInstance()
+
+	secretName, key, err := clusterproxy.GetSveltosSecretNameAndKey(ctx, logger, instance.GetClient(),
+		cluster.Namespace, cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := instance.GetResource(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: secretName},
+		secret); err != nil {
+		return nil, err
+	}
+
+	if key == "" {
+		// Same as Sveltos: with no key specified, the Secret is expected to contain only the kubeconfig
+		for k := range secret.Data {
+			key = k
+		}
+	}
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s does not contain a kubeconfig", secret.Namespace, secret.Name)
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	currentContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig current context %q not found", config.CurrentContext)
+	}
+	authInfo, ok := config.AuthInfos[currentContext.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig user %q not found", currentContext.AuthInfo)
+	}
+
+	stored := &storedKubeconfig{secret: secret, key: key, config: config, authInfo: authInfo}
+	if authInfo.Token != "" {
+		stored.claims, err = utils.ParseTokenClaims(authInfo.Token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stored, nil
+}
+
+// rotateKubeconfig mints a new TokenRequest in the managed cluster, using the stored kubeconfig,
+// and updates the stored kubeconfig with the new token. If expirationSeconds is zero, the new
+// token lasts as long as the current one.
+func rotateKubeconfig(ctx context.Context, stored *storedKubeconfig, expirationSeconds int64,
+	logger logr.Logger) (time.Time, error) {
+
+	saNamespace, saName, err := stored.claims.GetServiceAccount()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if expirationSeconds == 0 && stored.claims.IssuedAt != 0 {
+		expirationSeconds = stored.claims.Expiration - stored.claims.IssuedAt
+	}
+
+	data, err := clientcmd.Write(*stored.config)
+	if err != nil {
+		return time.Time{}, err
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return time.Time{}, err
+	}
+	clientset, err := getClientset(restConfig)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{}
+	if expirationSeconds != 0 {
+		tokenRequest.Spec.ExpirationSeconds = &expirationSeconds
+	}
+
+	logger.V(logs.LogDebug).Info(fmt.Sprintf("Create Token for ServiceAccount %s/%s", saNamespace, saName))
+	tokenRequest, err = clientset.CoreV1().ServiceAccounts(saNamespace).CreateToken(ctx, saName,
+		tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to create token for ServiceAccount %s/%s: %w",
+			saNamespace, saName, err)
+	}
+
+	stored.authInfo.Token = tokenRequest.Status.Token
+	data, err = clientcmd.Write(*stored.config)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	logger.V(logs.LogDebug).Info(fmt.Sprintf("Updating Secret %s/%s", stored.secret.Namespace, stored.secret.Name))
+	stored.secret.Data[stored.key] = data
+	if err := utils.GetAccessInstance().UpdateResource(ctx, stored.secret); err != nil {
+		return time.Time{}, err
+	}
+
+	return tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
+
+// rotateClusterKubeconfig rotates the kubeconfig of a SveltosCluster. If expiringWithin is
+// not zero, the kubeconfig is rotated only if its token expires within that duration.
+func rotateClusterKubeconfig(ctx context.Context, cluster *libsveltosv1beta1.SveltosCluster,
+	expiringWithin time.Duration, expirationSeconds int64, logger logr.Logger) rotationResult {
+
+	result := rotationResult{
+		cluster:    fmt.Sprintf("%s/%s", cluster.Namespace, cluster.Name),
+		expiration: never,
+	}
+
+	logger = logger.WithValues("cluster", result.cluster)
+
+	if cluster.Spec.PullMode {
+		result.result = resultSkipped
+		result.message = "cluster is in pull mode"
+		return result
+	}
+
+	stored, err := getStoredKubeconfig(ctx, cluster, logger)
+	if err != nil {
+		result.result = resultFailed
+		result.message = err.Error()
+		return result
+	}
+
+	if stored.claims == nil {
+		result.result = resultSkipped
+		result.message = "kubeconfig does not contain an expiring token"
+		return result
+	}
+
+	now := time.Now()
+	expiration := stored.claims.GetExpiration()
+	result.expiration = expiration.UTC().Format(time.RFC3339)
+
+	if expiringWithin != 0 && expiration.After(now.Add(expiringWithin)) {
+		result.result = resultSkipped
+		result.message = "token does not expire within the requested window"
+		return result
+	}
+
+	if !expiration.After(now) {
+		result.result = resultFailed
+		result.message = "token already expired: register the cluster again"
+		return result
+	}
+
+	newExpiration, err := rotateKubeconfig(ctx, stored, expirationSeconds, logger)
+	if err != nil {
+		result.result = resultFailed
+		result.message = err.Error()
+		return result
+	}
+
+	result.result = resultRotated
+	result.newExpiration = newExpiration.UTC().Format(time.RFC3339)
+	return result
+}
+
+// rotateKubeconfigs rotates the kubeconfig of the selected SveltosClusters and writes a report
+// to out. If clusterNamespace/clusterName are set, only that cluster is considered. Otherwise
+// all SveltosClusters whose token expires within expiringWithin are.
+func rotateKubeconfigs(ctx context.Context, clusterNamespace, clusterName string, expiringWithin time.Duration,
+	expirationSeconds int64, out io.Writer, logger logr.Logger) error {
+
+	instance := utils.GetAccessInstance()
+
+	clusters := make([]*libsveltosv1beta1.SveltosCluster, 0)
+	if clusterName != "" {
+		cluster := &libsveltosv1beta1.SveltosCluster{}
+		if err := instance.GetResource(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: clusterName},
+			cluster); err != nil {
+			return err
+		}
+		clusters = append(clusters, cluster)
+	} else {
+		sveltosClusters := &libsveltosv1beta1.SveltosClusterList{}
+		if err := instance.ListResources(ctx, sveltosClusters); err != nil {
+			return err
+		}
+		for i := range sveltosClusters.Items {
+			clusters = append(clusters, &sveltosClusters.Items[i])
+		}
+	}
+
+	table := tablewriter.NewWriter(out)
+	table.Header(genRotateHeader())
+
+	failed := 0
+	for i := range clusters {
+		result := rotateClusterKubeconfig(ctx, clusters[i], expiringWithin, expirationSeconds, logger)
+		if result.result == resultFailed {
+			failed++
+		}
+		if err := table.Append([]string{result.cluster, result.expiration, result.result,
+			result.newExpiration, result.message}); err != nil {
+			return err
+		}
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if failed != 0 {
+		return fmt.Errorf("failed to rotate the kubeconfig of %d clusters", failed)
+	}
+	return nil
+}
+
+// Kubeconfig rotates the kubeconfig stored for SveltosClusters
+func Kubeconfig(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl rotate kubeconfig [options] (--cluster=<namespace/name>|--all-expiring-within=<duration>)
+                               [--expirationSeconds=<value>] [--verbose]
+
+     --cluster=<namespace/name>          Rotates the kubeconfig of this SveltosCluster.
+     --all-expiring-within=<duration>    Rotates the kubeconfig of all SveltosClusters whose token
+                                         expires within this duration (for instance 72h).
+     --expirationSeconds=<value>         (Optional) Duration, in seconds, of the new token.
+                                         If not specified, the new token lasts as long as the current one.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The rotate kubeconfig command decodes the kubeconfig stored in the management cluster for a
+  SveltosCluster and checks when its token expires. Using that kubeconfig, it then creates a new
+  TokenRequest, in the managed cluster, for the same ServiceAccount and stores the new token in
+  the kubeconfig Secret. A report of the rotated clusters is displayed.
+  Tokens already expired cannot be rotated this way: such clusters need to be registered again.
+  Kubeconfigs with non expiring tokens and clusters in pull mode are skipped.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	var expirationSeconds int64
+	if passedExpiration := parsedArgs["--expirationSeconds"]; passedExpiration != nil {
+		expirationSeconds, err = strconv.ParseInt(passedExpiration.(string), 10, 64)
+		if err != nil || expirationSeconds <= 0 {
+			return errors.New("expirationSeconds must be a positive integer")
+		}
+	}
+
+	clusterNamespace, clusterName := "", ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		clusterNamespace, clusterName, err = utils.ParseClusterNamespaceName(passedCluster.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	var expiringWithin time.Duration
+	if passedWithin := parsedArgs["--all-expiring-within"]; passedWithin != nil {
+		expiringWithin, err = time.ParseDuration(passedWithin.(string))
+		if err != nil || expiringWithin <= 0 {
+			return fmt.Errorf("invalid all-expiring-within %q: must be a positive duration", passedWithin.(string))
+		}
+	}
+
+	return rotateKubeconfigs(ctx, clusterNamespace, clusterName, expiringWithin, expirationSeconds,
+		os.Stdout, logger)
+}
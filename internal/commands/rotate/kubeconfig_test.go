@@ -0,0 +1,177 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rotate_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/rotate"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	kubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: local
+  cluster:
+    server: https://managed:6443
+users:
+- name: projectsveltos
+  user:
+    token: %s
+contexts:
+- name: sveltos-context
+  context:
+    cluster: local
+    user: projectsveltos
+current-context: sveltos-context
+`
+	kubeconfigKey = "kubeconfig"
+)
+
+var _ = Describe("Rotate kubeconfig", func() {
+	var tokenRequests int
+
+	BeforeEach(func() {
+		tokenRequests = 0
+		clientset := fakeclientset.NewClientset()
+		clientset.PrependReactor("create", "serviceaccounts",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "token" {
+					return false, nil, nil
+				}
+				tokenRequests++
+				expiration := time.Now().Add(24 * time.Hour)
+				return true, &authenticationv1.TokenRequest{
+					Status: authenticationv1.TokenRequestStatus{
+						Token:               generateToken(time.Now(), expiration),
+						ExpirationTimestamp: metav1.Time{Time: expiration},
+					},
+				}, nil
+			})
+		rotate.SetClientset(clientset)
+	})
+
+	It("rotateKubeconfigs rotates only tokens expiring within the window", func() {
+		now := time.Now()
+		expiring, expiringSecret := getSveltosCluster(generateToken(now.Add(-time.Hour), now.Add(time.Hour)))
+		notExpiring, notExpiringSecret := getSveltosCluster(generateToken(now, now.Add(30*24*time.Hour)))
+		legacy, legacySecret := getSveltosCluster(randomString())
+		expired, expiredSecret := getSveltosCluster(generateToken(now.Add(-2*time.Hour), now.Add(-time.Hour)))
+
+		initializeAccess(expiring, expiringSecret, notExpiring, notExpiringSecret, legacy, legacySecret,
+			expired, expiredSecret)
+
+		var out bytes.Buffer
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		err := rotate.RotateKubeconfigs(context.TODO(), "", "", 72*time.Hour, 0, &out, logger)
+		// expired token cannot be rotated
+		Expect(err).ToNot(BeNil())
+		Expect(tokenRequests).To(Equal(1))
+
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf("%s .*│ rotated", expiring.Name)))
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf("%s .*│ skipped", notExpiring.Name)))
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf("%s .*│ skipped", legacy.Name)))
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf("%s .*│ failed", expired.Name)))
+
+		Expect(getStoredToken(expiringSecret)).ToNot(Equal(getToken(expiringSecret)))
+		Expect(getStoredToken(notExpiringSecret)).To(Equal(getToken(notExpiringSecret)))
+	})
+
+	It("rotateKubeconfigs rotates the kubeconfig of the requested cluster", func() {
+		now := time.Now()
+		cluster, secret := getSveltosCluster(generateToken(now, now.Add(30*24*time.Hour)))
+		initializeAccess(cluster, secret)
+
+		var out bytes.Buffer
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		Expect(rotate.RotateKubeconfigs(context.TODO(), cluster.Namespace, cluster.Name, 0, 3600,
+			&out, logger)).To(Succeed())
+		Expect(tokenRequests).To(Equal(1))
+		Expect(out.String()).To(MatchRegexp(fmt.Sprintf("%s .*│ rotated", cluster.Name)))
+
+		newToken := getStoredToken(secret)
+		Expect(newToken).ToNot(Equal(getToken(secret)))
+		expiration, err := utils.GetTokenExpiration(newToken)
+		Expect(err).To(BeNil())
+		Expect(expiration).ToNot(BeNil())
+	})
+})
+
+// generateToken returns an unsigned JWT with the claims of a ServiceAccount token
+func generateToken(issuedAt, expiration time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(
+		`{"iat":%d,"exp":%d,"sub":"system:serviceaccount:projectsveltos:projectsveltos","jti":%q}`,
+		issuedAt.Unix(), expiration.Unix(), randomString())))
+	return fmt.Sprintf("%s.%s.signature", header, payload)
+}
+
+func getSveltosCluster(token string) (*libsveltosv1beta1.SveltosCluster, *corev1.Secret) {
+	cluster := &libsveltosv1beta1.SveltosCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: randomString(), Name: randomString()},
+		Spec:       libsveltosv1beta1.SveltosClusterSpec{KubeconfigKeyName: kubeconfigKey},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: cluster.Namespace, Name: cluster.Name + "-sveltos-kubeconfig"},
+		Data:       map[string][]byte{kubeconfigKey: []byte(fmt.Sprintf(kubeconfigTemplate, token))},
+	}
+	return cluster, secret
+}
+
+// getToken returns the token in the kubeconfig contained in secret
+func getToken(secret *corev1.Secret) string {
+	config, err := clientcmd.Load(secret.Data[kubeconfigKey])
+	Expect(err).To(BeNil())
+	return config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo].Token
+}
+
+// getStoredToken returns the token in the kubeconfig currently stored in the management cluster
+func getStoredToken(secret *corev1.Secret) string {
+	current := &corev1.Secret{}
+	Expect(utils.GetAccessInstance().GetResource(context.TODO(),
+		types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, current)).To(Succeed())
+	return getToken(current)
+}
+
+func initializeAccess(objects ...client.Object) {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+}
@@ -41,6 +41,15 @@ func Show(ctx context.Context, args []string, logger logr.Logger) error {
     dryrun        Displays information on ClusterProfiles in DryRun mode. It displays what changes would
                   take effect if a ClusterProfile were to be moved out of DryRun mode.
     admin-rbac    Displays information about RBACs assigned to admins in each managed cluster.
+    eventtriggers Displays information on EventTriggers: referenced EventSource, matching clusters,
+                  EventReports received from each cluster and generated ClusterProfiles.
+    classifiers   Displays information on Classifiers: matching clusters, applied labels and conflicts.
+    healthchecks  Displays information on HealthChecks: evaluated clusters and resources' health in each cluster.
+    cluster       Displays everything Sveltos knows about a single managed cluster in one sectioned document.
+    profile       Displays which clusters a ClusterProfile/Profile matches and why, along with deployment status.
+    clustersummaries Displays, for each cluster and profile pair, per feature deployment status and failures.
+    sveltosclusters  Displays the inventory of registered clusters: readiness, connectivity, version and mode.
+    profiles      Displays rollout status of every ClusterProfile/Profile: matching, provisioned and failed clusters.
 
 Options:
   -h --help       Show this screen.
@@ -82,6 +91,22 @@ See 'sveltosctl show <subcommand> --help' to read about a specific subcommand.
 			err = show.Usage(ctx, arguments, logger)
 		case "admin-rbac":
 			err = show.AdminPermissions(ctx, arguments, logger)
+		case "eventtriggers":
+			err = show.EventTriggers(ctx, arguments, logger)
+		case "classifiers":
+			err = show.Classifiers(ctx, arguments, logger)
+		case "healthchecks":
+			err = show.HealthChecks(ctx, arguments, logger)
+		case "cluster":
+			err = show.Cluster(ctx, arguments, logger)
+		case "profile":
+			err = show.Profile(ctx, arguments, logger)
+		case "clustersummaries":
+			err = show.ClusterSummaries(ctx, arguments, logger)
+		case "sveltosclusters":
+			err = show.SveltosClusters(ctx, arguments, logger)
+		case "profiles":
+			err = show.Profiles(ctx, arguments, logger)
 		default:
 			//nolint: forbidigo // print doc
 			fmt.Println(doc)
@@ -21,85 +21,184 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/docopt/docopt-go"
 	"github.com/go-logr/logr"
 	"github.com/olekukonko/tablewriter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
 	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
 	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
-var (
-	// cluster represents the cluster => namespace/name
-	// resourceNamespace and resourceName is the kubernetes resource/helm release namespace/name
-	// resourceVersion applies to helm releases only and it is the helm chart version
-	// lastApplied represents the time resource was updated
-	// clusterProfileNames is the list of all ClusterProfiles causing the resource to be deployed
+const (
+	addOnListKind = "AddOnList"
+)
+
+// addOn is the result model of show addons. It represents either a helm release
+// or a Kubernetes resource deployed in a cluster.
+type addOn struct {
+	// Cluster is the cluster => namespace/name
+	Cluster string `json:"cluster"`
+	// ResourceType is either "helm chart" or the resource group:kind
+	ResourceType string `json:"resourceType"`
+	// Namespace and Name are the kubernetes resource/helm release namespace/name
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Version applies to helm releases only and it is the helm chart version
+	Version string `json:"version,omitempty"`
+	// AppVersion applies to helm releases only and it is the application version
+	AppVersion string `json:"appVersion,omitempty"`
+	// RepoURL applies to helm releases only and it is the helm repository URL
+	RepoURL string `json:"repoURL,omitempty"`
+	// LastAppliedTime represents the time resource was updated
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+	// DeploymentType indicates whether resource is deployed in the management or managed cluster
+	DeploymentType configv1beta1.DeploymentType `json:"deploymentType"`
+	// Profiles is the list of all ClusterProfiles/Profiles causing the resource to be deployed
 	// in the cluster
-	genAddOnsRow = func(cluster, resourceType, resourceNamespace, resourceName, resourceVersion,
-		lastApplied string, clusterProfileNames []string, deploymentType configv1beta1.DeploymentType) []string {
-		clusterProfiles := strings.Join(clusterProfileNames, ";")
+	Profiles []string `json:"profiles"`
+	// PausedProfiles is the list of paused ClusterProfiles/Profiles among Profiles
+	PausedProfiles []string `json:"pausedProfiles,omitempty"`
+}
+
+var (
+	genAddOnsHeader = func(wide bool) []string {
+		header := []string{"CLUSTER", "RESOURCE TYPE", "NAMESPACE", "NAME", "VERSION", "TIME", "DEPLOYMENT TYPE", "PROFILES"}
+		if wide {
+			header = append(header, "APP VERSION", "REPOSITORY")
+		}
+		return header
+	}
+
+	genAddOnsRow = func(a *addOn, wide bool) []string {
+		profiles := make([]string, len(a.Profiles))
+		for i := range a.Profiles {
+			profiles[i] = a.Profiles[i]
+			if slices.Contains(a.PausedProfiles, a.Profiles[i]) {
+				profiles[i] += pausedSuffix
+			}
+		}
+		clusterProfiles := strings.Join(profiles, ";")
 		location := "Managed cluster"
-		if deploymentType == configv1beta1.DeploymentTypeLocal {
+		if a.DeploymentType == configv1beta1.DeploymentTypeLocal {
 			location = "Management cluster"
 		}
-		return []string{
-			cluster,
-			resourceType,
-			resourceNamespace,
-			resourceName,
-			resourceVersion,
-			lastApplied,
+		version := a.Version
+		if version == "" {
+			version = "N/A"
+		}
+		row := []string{
+			a.Cluster,
+			a.ResourceType,
+			a.Namespace,
+			a.Name,
+			version,
+			a.LastAppliedTime.String(),
 			location,
 			clusterProfiles,
 		}
+		if wide {
+			row = append(row, a.AppVersion, a.RepoURL)
+		}
+		return row
 	}
 )
 
 func displayAddOns(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
-	logger logr.Logger) error {
+	format outputFormat, logger logr.Logger) error {
+
+	addOns, err := collectAddOnsInNamespaces(ctx, passedNamespace, passedCluster, passedProfile, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, addOnListKind, addOns)
+	}
 
 	table := tablewriter.NewWriter(os.Stdout)
-	table.Header("CLUSTER", "RESOURCE TYPE", "NAMESPACE", "NAME", "VERSION", "TIME", "DEPLOYMENT TYPE", "PROFILES")
+	table.Header(genAddOnsHeader(format.isWide()))
 
-	if err := displayAddOnsInNamespaces(ctx, passedNamespace, passedCluster,
-		passedProfile, table, logger); err != nil {
-		return err
+	for i := range addOns {
+		if err := table.Append(genAddOnsRow(&addOns[i], format.isWide())); err != nil {
+			return err
+		}
 	}
 
 	return table.Render()
 }
 
-func displayAddOnsInNamespaces(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
-	table *tablewriter.Table, logger logr.Logger) error {
+// watchAddOns displays add-ons and keeps the table up to date as ClusterConfigurations change
+func watchAddOns(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
+	format outputFormat, logger logr.Logger) error {
+
+	if format.isStructured() {
+		return errWatchStructuredOutput
+	}
+
+	wt := &watchedTable{
+		header:      genAddOnsHeader(format.isWide()),
+		lists:       []client.ObjectList{&configv1beta1.ClusterConfigurationList{}},
+		listOptions: namespaceListOptions(passedNamespace),
+		collect: func(ctx context.Context) ([]watchedRow, error) {
+			addOns, err := collectAddOnsInNamespaces(ctx, passedNamespace, passedCluster, passedProfile, logger)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([]watchedRow, len(addOns))
+			for i := range addOns {
+				rows[i] = watchedRow{
+					key: fmt.Sprintf("%s/%s/%s/%s", addOns[i].Cluster, addOns[i].ResourceType,
+						addOns[i].Namespace, addOns[i].Name),
+					cells: genAddOnsRow(&addOns[i], format.isWide()),
+				}
+			}
+			return rows, nil
+		},
+	}
+
+	return watchTable(ctx, wt, logger)
+}
+
+func collectAddOnsInNamespaces(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
+	logger logr.Logger) ([]addOn, error) {
 
 	instance := utils.GetAccessInstance()
 
 	namespaces, err := instance.ListNamespaces(ctx, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	pausedProfiles, err := getPausedProfiles(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	addOns := make([]addOn, 0)
 	for i := range namespaces.Items {
 		ns := &namespaces.Items[i]
 		if doConsiderNamespace(ns, passedNamespace) {
 			logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering namespace: %s", ns.Name))
-			err = displayAddOnsInNamespace(ctx, ns.Name, passedCluster, passedProfile,
-				table, logger)
+			nsAddOns, err := collectAddOnsInNamespace(ctx, ns.Name, passedCluster, passedProfile,
+				pausedProfiles, logger)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			addOns = append(addOns, nsAddOns...)
 		}
 	}
 
-	return nil
+	return addOns, nil
 }
 
-func displayAddOnsInNamespace(ctx context.Context, namespace, passedCluster, passedProfile string,
-	table *tablewriter.Table, logger logr.Logger) error {
+func collectAddOnsInNamespace(ctx context.Context, namespace, passedCluster, passedProfile string,
+	pausedProfiles map[string]bool, logger logr.Logger) ([]addOn, error) {
 
 	instance := utils.GetAccessInstance()
 
@@ -107,24 +206,23 @@ func displayAddOnsInNamespace(ctx context.Context, namespace, passedCluster, pas
 	logger.V(logs.LogDebug).Info("Get all ClusterConfiguration")
 	clusterConfigurations, err := instance.ListClusterConfigurations(ctx, namespace, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	addOns := make([]addOn, 0)
 	for i := range clusterConfigurations.Items {
 		cc := &clusterConfigurations.Items[i]
 		if doConsiderClusterConfiguration(cc, passedCluster) {
 			logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering ClusterConfiguration: %s", cc.Name))
-			if err := displayAddOnsForCluster(cc, passedProfile, table, logger); err != nil {
-				return err
-			}
+			addOns = append(addOns, collectAddOnsForCluster(cc, passedProfile, pausedProfiles, logger)...)
 		}
 	}
 
-	return nil
+	return addOns, nil
 }
 
-func displayAddOnsForCluster(clusterConfiguration *configv1beta1.ClusterConfiguration, passedProfile string,
-	table *tablewriter.Table, logger logr.Logger) error {
+func collectAddOnsForCluster(clusterConfiguration *configv1beta1.ClusterConfiguration, passedProfile string,
+	pausedProfiles map[string]bool, logger logr.Logger) []addOn {
 
 	instance := utils.GetAccessInstance()
 	helmCharts := instance.GetHelmReleases(clusterConfiguration, logger)
@@ -135,32 +233,65 @@ func displayAddOnsForCluster(clusterConfiguration *configv1beta1.ClusterConfigur
 	clusterName := instance.GetClusterNameFromClusterConfiguration(clusterConfiguration)
 
 	clusterInfo := fmt.Sprintf("%s/%s", clusterConfiguration.Namespace, clusterName)
+
+	addOns := make([]addOn, 0)
 	for chart := range helmCharts {
 		if doConsiderProfile(helmCharts[chart], passedProfile) {
-			if err := table.Append(genAddOnsRow(clusterInfo, "helm chart", chart.Namespace, chart.ReleaseName, chart.ChartVersion,
-				chart.LastAppliedTime.String(), helmCharts[chart], configv1beta1.DeploymentTypeRemote)); err != nil {
-				return err
-			}
+			addOns = append(addOns, addOn{
+				Cluster:         clusterInfo,
+				ResourceType:    "helm chart",
+				Namespace:       chart.Namespace,
+				Name:            chart.ReleaseName,
+				Version:         chart.ChartVersion,
+				AppVersion:      chart.AppVersion,
+				RepoURL:         chart.RepoURL,
+				LastAppliedTime: chart.LastAppliedTime,
+				DeploymentType:  configv1beta1.DeploymentTypeRemote,
+				Profiles:        helmCharts[chart],
+				PausedProfiles:  getPausedAddOnProfiles(helmCharts[chart], clusterConfiguration.Namespace, pausedProfiles),
+			})
 		}
 	}
 
 	resources := instance.GetResources(clusterConfiguration, logger)
 	for resource := range resources {
 		if doConsiderProfile(resources[resource], passedProfile) {
-			if err := table.Append(genAddOnsRow(clusterInfo, fmt.Sprintf("%s:%s", resource.Group, resource.Kind),
-				resource.Namespace, resource.Name, "N/A",
-				resource.LastAppliedTime.String(), resources[resource], resource.DeploymentType)); err != nil {
-				return err
-			}
+			addOns = append(addOns, addOn{
+				Cluster:         clusterInfo,
+				ResourceType:    fmt.Sprintf("%s:%s", resource.Group, resource.Kind),
+				Namespace:       resource.Namespace,
+				Name:            resource.Name,
+				LastAppliedTime: resource.LastAppliedTime,
+				DeploymentType:  resource.DeploymentType,
+				Profiles:        resources[resource],
+				PausedProfiles:  getPausedAddOnProfiles(resources[resource], clusterConfiguration.Namespace, pausedProfiles),
+			})
+		}
+	}
+	return addOns
+}
+
+// getPausedAddOnProfiles returns the paused profiles among the ClusterProfile/<name> and
+// Profile/<name> causing an add-on to be deployed in a cluster in namespace
+func getPausedAddOnProfiles(profiles []string, namespace string, pausedProfiles map[string]bool) []string {
+	var result []string
+	for i := range profiles {
+		key := profiles[i]
+		if name, ok := strings.CutPrefix(key, configv1beta1.ProfileKind+"/"); ok {
+			// Profiles can only match clusters in their own namespace
+			key = fmt.Sprintf("%s/%s/%s", configv1beta1.ProfileKind, namespace, name)
+		}
+		if pausedProfiles[key] {
+			result = append(result, profiles[i])
 		}
 	}
-	return nil
+	return result
 }
 
 // AddOns displays information about Kubernetes AddOns deployed in clusters
 func AddOns(ctx context.Context, args []string, logger logr.Logger) error {
 	doc := `Usage:
-  sveltosctl show addons [options] [--namespace=<name>] [--cluster=<name>] [--profile=<name>] [--verbose]
+  sveltosctl show addons [options] [--namespace=<name>] [--cluster=<name>] [--profile=<name>] [--output=<format>] [--watch] [--verbose]
 
      --namespace=<name>      Show Kubernetes addons deployed in clusters in this namespace.
                              If not specified all namespaces are considered.
@@ -171,10 +302,15 @@ func AddOns(ctx context.Context, args []string, logger logr.Logger) error {
 
 Options:
   -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --watch                 After displaying addons, watch ClusterConfigurations and display changes.
      --verbose               Verbose mode. Print each step.
 
 Description:
   The show addons command shows information about Kubernetes addons deployed in clusters.
+  Paused ClusterProfiles/Profiles are marked "(paused)".
+  With --watch, the table is redrawn on every change when stdout is a terminal. Otherwise
+  only added, modified and deleted rows are printed, each prefixed by a timestamp.
 `
 	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
 	if err != nil {
@@ -213,5 +349,14 @@ Description:
 		profile = passedProfile.(string)
 	}
 
-	return displayAddOns(ctx, namespace, cluster, profile, logger)
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	if parsedArgs["--watch"].(bool) {
+		return watchAddOns(ctx, namespace, cluster, profile, format, logger)
+	}
+
+	return displayAddOns(ctx, namespace, cluster, profile, format, logger)
 }
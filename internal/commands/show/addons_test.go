@@ -83,7 +83,7 @@ var _ = Describe("AddOnss", func() {
 		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
 
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
-		err = show.DisplayAddOns(context.TODO(), "", "", "",
+		err = show.DisplayAddOns(context.TODO(), "", "", "", show.OutputFormatTable,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 
@@ -134,7 +134,7 @@ var _ = Describe("AddOnss", func() {
 		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
 
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
-		err = show.DisplayAddOns(context.TODO(), "", "", "",
+		err = show.DisplayAddOns(context.TODO(), "", "", "", show.OutputFormatTable,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 
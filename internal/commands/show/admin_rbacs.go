@@ -38,28 +38,82 @@ import (
 	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
+const (
+	adminRbacListKind = "AdminRbacList"
+)
+
+// adminRbac is the result model of show admin-rbac. It represents a rule granted to
+// an admin in a managed cluster.
+type adminRbac struct {
+	// Cluster is the managed cluster => kind:namespace/name
+	Cluster string `json:"cluster"`
+	// Admin is the ServiceAccount => namespace/name
+	Admin string `json:"admin"`
+	// Namespace is the namespace the rule applies to. * for ClusterRoles
+	Namespace     string   `json:"namespace"`
+	APIGroups     []string `json:"apiGroups"`
+	Resources     []string `json:"resources"`
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	Verbs         []string `json:"verbs"`
+	// RoleRequest is the name of the RoleRequest granting the rule
+	RoleRequest string `json:"roleRequest"`
+}
+
 var (
-	// resourceKind indentifies the type of resource (ClusterProfile, ConfigMap, Secret)
-	// resourceNamespace and resourceName is the kubernetes resource namespace/name
-	// clusters is the list of clusters where resource content is deployed
-	genAdminRbac = func(clusterKind, clusterNamespace, clusterName, serviceAccountNamespace, serviceAccountName,
-		namespace, apigroups, resources, resourceNames, verbs string,
-	) []string {
-		return []string{
-			fmt.Sprintf("%s:%s/%s", clusterKind, clusterNamespace, clusterName),
-			fmt.Sprintf("%s/%s", serviceAccountNamespace, serviceAccountName),
-			namespace,
-			apigroups,
-			resources,
-			resources,
-			verbs,
+	genAdminRbacHeader = func(wide bool) []string {
+		header := []string{"CLUSTER", "ADMIN", "NAMESPACE", "API GROUPS", "RESOURCES", "RESOURCE NAMES", "VERBS"}
+		if wide {
+			header = append(header, "ROLEREQUEST")
+		}
+		return header
+	}
+
+	genAdminRbacRow = func(a *adminRbac, wide bool) []string {
+		row := []string{
+			a.Cluster,
+			a.Admin,
+			a.Namespace,
+			strings.Join(a.APIGroups, ","),
+			strings.Join(a.Resources, ","),
+			strings.Join(a.ResourceNames, ","),
+			strings.Join(a.Verbs, ","),
+		}
+		if wide {
+			row = append(row, a.RoleRequest)
 		}
+		return row
 	}
 )
 
 func displayAdminRbacs(ctx context.Context,
 	passedNamespace, passedCluster, passedServiceAccountNamespace, passedServiceAccountName string,
-	logger logr.Logger) error {
+	format outputFormat, logger logr.Logger) error {
+
+	rbacs, err := collectAdminRbacs(ctx, passedNamespace, passedCluster, passedServiceAccountNamespace,
+		passedServiceAccountName, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, adminRbacListKind, rbacs)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genAdminRbacHeader(format.isWide()))
+
+	for i := range rbacs {
+		if err := table.Append(genAdminRbacRow(&rbacs[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+func collectAdminRbacs(ctx context.Context,
+	passedNamespace, passedCluster, passedServiceAccountNamespace, passedServiceAccountName string,
+	logger logr.Logger) ([]adminRbac, error) {
 
 	// Collect all RoleRequest
 	instance := utils.GetAccessInstance()
@@ -67,28 +121,27 @@ func displayAdminRbacs(ctx context.Context,
 	logger.V(logs.LogDebug).Info("collect all rolerequests")
 	roleRequests, err := instance.ListRoleRequests(ctx, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("found %d roleRequests", len(roleRequests.Items)))
 
-	table := tablewriter.NewWriter(os.Stdout)
-	table.Header("CLUSTER", "ADMIN", "NAMESPACE", "API GROUPS", "RESOURCES", "RESOURCE NAMES", "VERBS")
-
 	// Build a map: key is the cluster, value is the slices of rolerequests matching that cluster
 	clusterMap := createRoleRequestsPerClusterMap(roleRequests, logger)
 
+	rbacs := make([]adminRbac, 0)
 	for k := range clusterMap {
 		l := logger.WithValues("cluster", fmt.Sprintf("%s:%s/%s", k.Kind, k.Namespace, k.Name))
 		l.V(logs.LogDebug).Info("considering cluster")
-		err = parseCluster(ctx, &k, clusterMap[k], passedNamespace, passedCluster, passedServiceAccountNamespace,
-			passedServiceAccountName, table, l)
+		result, err := parseCluster(ctx, &k, clusterMap[k], passedNamespace, passedCluster,
+			passedServiceAccountNamespace, passedServiceAccountName, l)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		rbacs = append(rbacs, result...)
 	}
 
-	return table.Render()
+	return rbacs, nil
 }
 
 func createRoleRequestsPerClusterMap(roleRequests *libsveltosv1beta1.RoleRequestList,
@@ -122,22 +175,25 @@ func parseMatchihgCluster(rr *libsveltosv1beta1.RoleRequest,
 func parseCluster(ctx context.Context, cluster *corev1.ObjectReference,
 	roleRequests []*libsveltosv1beta1.RoleRequest,
 	passedNamespace, passedCluster, passedServiceAccountNamespace, passedServiceAccountName string,
-	table *tablewriter.Table, logger logr.Logger) error {
+	logger logr.Logger) ([]adminRbac, error) {
 
+	rbacs := make([]adminRbac, 0)
 	if passedNamespace == "" || passedNamespace == cluster.Namespace {
 		if passedCluster == "" || passedCluster == cluster.Name {
 			logger.V(logs.LogDebug).Info("examining admin rbacs in cluster")
 			for i := range roleRequests {
-				if err := parseRoleRequest(ctx, roleRequests[i], cluster.Namespace,
+				result, err := parseRoleRequest(ctx, roleRequests[i], cluster.Namespace,
 					cluster.Name, cluster.Kind, passedServiceAccountNamespace, passedServiceAccountName,
-					table, logger); err != nil {
-					return err
+					logger)
+				if err != nil {
+					return nil, err
 				}
+				rbacs = append(rbacs, result...)
 			}
 		}
 	}
 
-	return nil
+	return rbacs, nil
 }
 
 func shouldParseRoleRequest(roleRequest *libsveltosv1beta1.RoleRequest,
@@ -160,110 +216,98 @@ func shouldParseRoleRequest(roleRequest *libsveltosv1beta1.RoleRequest,
 
 func parseRoleRequest(ctx context.Context, roleRequest *libsveltosv1beta1.RoleRequest,
 	clusterNamespace, clusterName, clusterKind, passedServiceAccountNamespace, passedServiceAccountName string,
-	table *tablewriter.Table, logger logr.Logger) error {
+	logger logr.Logger) ([]adminRbac, error) {
 
 	logger = logger.WithValues("admin", fmt.Sprintf("%s/%s",
 		roleRequest.Spec.ServiceAccountNamespace, roleRequest.Spec.ServiceAccountName))
 	logger.V(logs.LogDebug).Info("considering rolerequest %s", roleRequest.Name)
+
+	rbacs := make([]adminRbac, 0)
 	if shouldParseRoleRequest(roleRequest, passedServiceAccountNamespace, passedServiceAccountName) {
 		logger.V(logs.LogDebug).Info("rolerequest is for admin")
+		base := adminRbac{
+			Cluster:     fmt.Sprintf("%s:%s/%s", clusterKind, clusterNamespace, clusterName),
+			Admin:       fmt.Sprintf("%s/%s", roleRequest.Spec.ServiceAccountNamespace, roleRequest.Spec.ServiceAccountName),
+			RoleRequest: roleRequest.Name,
+		}
 		for i := range roleRequest.Spec.RoleRefs {
-			if err := parseReferencedResource(ctx, clusterNamespace, clusterName, clusterKind,
-				roleRequest.Spec.ServiceAccountNamespace, roleRequest.Spec.ServiceAccountName,
-				roleRequest.Spec.RoleRefs[i], table, logger); err != nil {
-				return err
+			result, err := parseReferencedResource(ctx, &base, roleRequest.Spec.RoleRefs[i], logger)
+			if err != nil {
+				return nil, err
 			}
+			rbacs = append(rbacs, result...)
 		}
 	}
 
-	return nil
+	return rbacs, nil
 }
 
-func parseReferencedResource(ctx context.Context,
-	clusterNamespace, clusterName, clusterKind, serviceAccountNamespace, serviceAccountName string,
-	resource libsveltosv1beta1.PolicyRef, table *tablewriter.Table, logger logr.Logger) error {
+// parseReferencedResource returns a row for each rule contained in the Roles/ClusterRoles
+// defined in the referenced ConfigMap/Secret. Cluster, Admin and RoleRequest are copied from base.
+func parseReferencedResource(ctx context.Context, base *adminRbac,
+	resource libsveltosv1beta1.PolicyRef, logger logr.Logger) ([]adminRbac, error) {
 
 	// fetch resource
 	content, err := collectResourceContent(ctx, resource, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	rbacs := make([]adminRbac, 0)
 	for i := range content {
 		if content[i].GroupVersionKind().Kind == "Role" {
-			err = processRole(content[i], clusterNamespace, clusterName, clusterKind,
-				serviceAccountNamespace, serviceAccountName, table, logger)
+			result, err := processRole(content[i], base, logger)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			rbacs = append(rbacs, result...)
 		} else if content[i].GroupVersionKind().Kind == "ClusterRole" {
-			err = processClusterRole(content[i], clusterNamespace, clusterName, clusterKind,
-				serviceAccountNamespace, serviceAccountName, table, logger)
+			result, err := processClusterRole(content[i], base, logger)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			rbacs = append(rbacs, result...)
 		} else {
 			logger.V(logs.LogDebug).Info("resource is neither Role or ClusterRole")
 		}
 	}
 
-	return nil
+	return rbacs, nil
 }
 
-func processRole(u *unstructured.Unstructured,
-	clusterNamespace, clusterName, clusterKind, serviceAccountNamespace, serviceAccountName string,
-	table *tablewriter.Table, logger logr.Logger) error {
-
+func processRole(u *unstructured.Unstructured, base *adminRbac, logger logr.Logger) ([]adminRbac, error) {
 	role := &rbacv1.Role{}
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), role); err != nil {
-		return err
+		return nil, err
 	}
 	logger = logger.WithValues("role", fmt.Sprintf("%s/%s", role.Namespace, role.Name))
 	logger.V(logs.LogDebug).Info("process role")
 
-	for i := range role.Rules {
-		rule := role.Rules[i]
-		resourceNames := ""
-		if rule.ResourceNames != nil {
-			resourceNames = strings.Join(rule.ResourceNames, ",")
-		}
-
-		if err := table.Append(genAdminRbac(clusterKind, clusterNamespace, clusterName, serviceAccountNamespace,
-			serviceAccountName, role.Namespace, strings.Join(rule.APIGroups, ","), strings.Join(rule.Resources, ","),
-			resourceNames, strings.Join(rule.Verbs, ","))); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return getAdminRbacsFromRules(base, role.Namespace, role.Rules), nil
 }
 
-func processClusterRole(u *unstructured.Unstructured,
-	clusterNamespace, clusterName, clusterKind, serviceAccountNamespace, serviceAccountName string,
-	table *tablewriter.Table, logger logr.Logger) error {
-
+func processClusterRole(u *unstructured.Unstructured, base *adminRbac, logger logr.Logger) ([]adminRbac, error) {
 	clusterRole := &rbacv1.ClusterRole{}
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), clusterRole); err != nil {
-		return err
+		return nil, err
 	}
 	logger = logger.WithValues("role", fmt.Sprintf("%s/%s", clusterRole.Namespace, clusterRole.Name))
 	logger.V(logs.LogDebug).Info("process role")
 
-	for i := range clusterRole.Rules {
-		rule := clusterRole.Rules[i]
-		resourceNames := ""
-		if rule.ResourceNames != nil {
-			resourceNames = strings.Join(rule.ResourceNames, ",")
-		}
+	return getAdminRbacsFromRules(base, "*", clusterRole.Rules), nil
+}
 
-		if err := table.Append(genAdminRbac(clusterKind, clusterNamespace, clusterName, serviceAccountNamespace, serviceAccountName,
-			"*", strings.Join(rule.APIGroups, ","), strings.Join(rule.Resources, ","),
-			resourceNames, strings.Join(rule.Verbs, ","))); err != nil {
-			return err
-		}
+func getAdminRbacsFromRules(base *adminRbac, namespace string, rules []rbacv1.PolicyRule) []adminRbac {
+	rbacs := make([]adminRbac, len(rules))
+	for i := range rules {
+		rbacs[i] = *base
+		rbacs[i].Namespace = namespace
+		rbacs[i].APIGroups = rules[i].APIGroups
+		rbacs[i].Resources = rules[i].Resources
+		rbacs[i].ResourceNames = rules[i].ResourceNames
+		rbacs[i].Verbs = rules[i].Verbs
 	}
-
-	return nil
+	return rbacs
 }
 
 func collectResourceContent(ctx context.Context, resource libsveltosv1beta1.PolicyRef, logger logr.Logger,
@@ -328,7 +372,8 @@ func collectContent(data map[string]string, logger logr.Logger) ([]*unstructured
 // AdminPermissions displays information about permissions each admin has in each managed cluster
 func AdminPermissions(ctx context.Context, args []string, logger logr.Logger) error {
 	doc := `Usage:
-  sveltosctl show admin-rbac [options] [--namespace=<name>] [--cluster=<name>] [--serviceAccountName=<name>] [--serviceAccountNamespace=<name>] [--verbose]
+  sveltosctl show admin-rbac [options] [--namespace=<name>] [--cluster=<name>] [--serviceAccountName=<name>] [--serviceAccountNamespace=<name>]
+                             [--output=<format>] [--verbose]
 
      --serviceAccountName=<name>            Show permissions for this ServiceAccount.
                                             If not specified all admins are considered.
@@ -341,6 +386,7 @@ func AdminPermissions(ctx context.Context, args []string, logger logr.Logger) er
 
 Options:
   -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
      --verbose               Verbose mode. Print each step.  
 
 Description:
@@ -388,5 +434,10 @@ Description:
 		saNamespace = passedSaNamespace.(string)
 	}
 
-	return displayAdminRbacs(ctx, namespace, cluster, saNamespace, saName, logger)
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayAdminRbacs(ctx, namespace, cluster, saNamespace, saName, format, logger)
 }
@@ -105,7 +105,7 @@ var _ = Describe("Admin RBACs", func() {
 		os.Stdout = w
 
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
-		err = show.DisplayAdminRbacs(context.TODO(), "", "", "", "",
+		err = show.DisplayAdminRbacs(context.TODO(), "", "", "", "", show.OutputFormatTable,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 
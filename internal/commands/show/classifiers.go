@@ -0,0 +1,327 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	classifierListKind = "ClassifierList"
+)
+
+// classifier is the result model of show classifiers. It represents a Classifier and
+// one of the clusters which sent a ClassifierReport for it.
+type classifier struct {
+	// Name is the Classifier name
+	Name string `json:"name"`
+	// Cluster is the cluster => namespace/name. Empty when no cluster
+	// has reported on this Classifier yet
+	Cluster     string                        `json:"cluster,omitempty"`
+	ClusterType libsveltosv1beta1.ClusterType `json:"clusterType,omitempty"`
+	// Match indicates whether cluster is currently a match for the Classifier
+	Match bool `json:"match"`
+	// ClassifierReport is the ClassifierReport sent by the cluster => namespace/name
+	ClassifierReport string `json:"classifierReport,omitempty"`
+	// ReportPhase is the ClassifierReport phase
+	ReportPhase string `json:"reportPhase,omitempty"`
+	// Labels are the labels (key=value) the Classifier applied to the cluster
+	Labels []string `json:"labels,omitempty"`
+	// Conflicts are the labels the Classifier wants to set on the cluster but cannot
+	Conflicts []classifierConflict `json:"conflicts,omitempty"`
+}
+
+// classifierConflict represents a label the Classifier and a different Classifier
+// want to set to different values on the same cluster
+type classifierConflict struct {
+	// Key is the label key
+	Key string `json:"key"`
+	// Classifier is the Classifier setting the label key to a different Value
+	Classifier string `json:"classifier,omitempty"`
+	Value      string `json:"value,omitempty"`
+	// Message is the failure message reported by Classifier for this label
+	Message string `json:"message,omitempty"`
+}
+
+var (
+	genClassifierHeader = func(wide bool) []string {
+		header := []string{"CLASSIFIER", "CLUSTER", "MATCH", "APPLIED LABELS", "CONFLICTS"}
+		if wide {
+			header = append(header, "CLASSIFIERREPORT", "PHASE")
+		}
+		return header
+	}
+
+	genClassifierRow = func(c *classifier, wide bool) []string {
+		match := ""
+		if c.Cluster != "" {
+			match = fmt.Sprintf("%t", c.Match)
+		}
+		conflicts := make([]string, len(c.Conflicts))
+		for i := range c.Conflicts {
+			if c.Conflicts[i].Classifier != "" {
+				conflicts[i] = fmt.Sprintf("%s: %s=%s", c.Conflicts[i].Key,
+					c.Conflicts[i].Classifier, c.Conflicts[i].Value)
+			} else {
+				conflicts[i] = fmt.Sprintf("%s: %s", c.Conflicts[i].Key, c.Conflicts[i].Message)
+			}
+		}
+		row := []string{
+			c.Name,
+			c.Cluster,
+			match,
+			strings.Join(c.Labels, "\n"),
+			strings.Join(conflicts, "\n"),
+		}
+		if wide {
+			row = append(row, c.ClassifierReport, c.ReportPhase)
+		}
+		return row
+	}
+)
+
+func displayClassifiers(ctx context.Context, passedNamespace, passedCluster, passedClassifier string,
+	format outputFormat, logger logr.Logger) error {
+
+	classifiers, err := collectClassifiers(ctx, passedNamespace, passedCluster, passedClassifier, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, classifierListKind, classifiers)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genClassifierHeader(format.isWide()))
+
+	for i := range classifiers {
+		if err := table.Append(genClassifierRow(&classifiers[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+func getClusterKey(clusterType libsveltosv1beta1.ClusterType, clusterNamespace, clusterName string) string {
+	return fmt.Sprintf("%s:%s/%s", clusterType, clusterNamespace, clusterName)
+}
+
+func collectClassifiers(ctx context.Context, passedNamespace, passedCluster, passedClassifier string,
+	logger logr.Logger) ([]classifier, error) {
+
+	instance := utils.GetAccessInstance()
+
+	classifiers, err := instance.ListClassifiers(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	classifierReports, err := instance.ListClassifierReports(ctx, passedNamespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Key: cluster. Value: Classifiers currently matching the cluster
+	matching := make(map[string][]*libsveltosv1beta1.Classifier)
+	// Key: classifier name. Value: ClassifierReports for the classifier
+	reports := make(map[string][]*libsveltosv1beta1.ClassifierReport)
+
+	classifierMap := make(map[string]*libsveltosv1beta1.Classifier, len(classifiers.Items))
+	for i := range classifiers.Items {
+		classifierMap[classifiers.Items[i].Name] = &classifiers.Items[i]
+	}
+
+	for i := range classifierReports.Items {
+		cr := &classifierReports.Items[i]
+		reports[cr.Spec.ClassifierName] = append(reports[cr.Spec.ClassifierName], cr)
+		if c, ok := classifierMap[cr.Spec.ClassifierName]; ok && cr.Spec.Match {
+			clusterKey := getClusterKey(cr.Spec.ClusterType, cr.Spec.ClusterNamespace, cr.Spec.ClusterName)
+			matching[clusterKey] = append(matching[clusterKey], c)
+		}
+	}
+
+	result := make([]classifier, 0)
+	for i := range classifiers.Items {
+		c := &classifiers.Items[i]
+		if passedClassifier != "" && c.Name != passedClassifier {
+			continue
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering Classifier %s", c.Name))
+
+		considered := 0
+		for _, cr := range reports[c.Name] {
+			if passedCluster != "" && cr.Spec.ClusterName != passedCluster {
+				continue
+			}
+			result = append(result, getClassifierInCluster(c, cr, matching))
+			considered++
+		}
+
+		if considered == 0 && passedNamespace == "" && passedCluster == "" {
+			result = append(result, classifier{Name: c.Name})
+		}
+	}
+
+	return result, nil
+}
+
+func getClassifierInCluster(c *libsveltosv1beta1.Classifier, cr *libsveltosv1beta1.ClassifierReport,
+	matching map[string][]*libsveltosv1beta1.Classifier) classifier {
+
+	result := classifier{
+		Name:             c.Name,
+		Cluster:          fmt.Sprintf("%s/%s", cr.Spec.ClusterNamespace, cr.Spec.ClusterName),
+		ClusterType:      cr.Spec.ClusterType,
+		Match:            cr.Spec.Match,
+		ClassifierReport: fmt.Sprintf("%s/%s", cr.Namespace, cr.Name),
+	}
+	if cr.Status.Phase != nil {
+		result.ReportPhase = string(*cr.Status.Phase)
+	}
+
+	if !cr.Spec.Match {
+		return result
+	}
+
+	labels := make(map[string]string, len(c.Spec.ClassifierLabels))
+	for i := range c.Spec.ClassifierLabels {
+		labels[c.Spec.ClassifierLabels[i].Key] = c.Spec.ClassifierLabels[i].Value
+	}
+
+	for i := range c.Status.MachingClusterStatuses {
+		status := &c.Status.MachingClusterStatuses[i]
+		if status.ClusterRef.Namespace != cr.Spec.ClusterNamespace || status.ClusterRef.Name != cr.Spec.ClusterName ||
+			clusterproxy.GetClusterType(&status.ClusterRef) != cr.Spec.ClusterType {
+
+			continue
+		}
+		for j := range status.ManagedLabels {
+			key := status.ManagedLabels[j]
+			result.Labels = append(result.Labels, fmt.Sprintf("%s=%s", key, labels[key]))
+		}
+		for j := range status.UnManagedLabels {
+			conflict := classifierConflict{Key: status.UnManagedLabels[j].Key}
+			if status.UnManagedLabels[j].FailureMessage != nil {
+				conflict.Message = *status.UnManagedLabels[j].FailureMessage
+			}
+			result.Conflicts = append(result.Conflicts, conflict)
+		}
+	}
+
+	// Classifiers matching same cluster and setting same label key to a different value
+	clusterKey := getClusterKey(cr.Spec.ClusterType, cr.Spec.ClusterNamespace, cr.Spec.ClusterName)
+	for _, other := range matching[clusterKey] {
+		if other.Name == c.Name {
+			continue
+		}
+		for i := range other.Spec.ClassifierLabels {
+			otherLabel := &other.Spec.ClassifierLabels[i]
+			if value, ok := labels[otherLabel.Key]; ok && value != otherLabel.Value {
+				result.Conflicts = append(result.Conflicts, classifierConflict{
+					Key:        otherLabel.Key,
+					Classifier: other.Name,
+					Value:      otherLabel.Value,
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+// Classifiers displays information about Classifiers and the labels they applied in each cluster
+func Classifiers(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show classifiers [options] [--name=<name>] [--namespace=<name>] [--cluster=<name>]
+                              [--output=<format>] [--verbose]
+
+     --name=<name>          Show information about Classifier with this name.
+                            If not specified all Classifiers are considered.
+     --namespace=<name>     Show Classifiers' outcome in clusters in this namespace.
+                            If not specified all namespaces are considered.
+     --cluster=<name>       Show Classifiers' outcome in cluster with name.
+                            If not specified all cluster names are considered.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show classifiers command shows, for each Classifier, whether each cluster is a match (as
+  reported by ClassifierReports), the labels applied to matching clusters and the labels
+  Classifier cannot set because a different Classifier wants same label key with a different value.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	name := ""
+	if passedName := parsedArgs["--name"]; passedName != nil {
+		name = passedName.(string)
+	}
+
+	namespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		namespace = passedNamespace.(string)
+	}
+
+	cluster := ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		cluster = passedCluster.(string)
+	}
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayClassifiers(ctx, namespace, cluster, name, format, logger)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Classifiers", func() {
+	It("show classifiers displays matching clusters, applied labels and conflicts", func() {
+		cluster := corev1.ObjectReference{
+			Namespace:  randomString(),
+			Name:       randomString(),
+			Kind:       libsveltosv1beta1.SveltosClusterKind,
+			APIVersion: libsveltosv1beta1.GroupVersion.String(),
+		}
+		otherCluster := corev1.ObjectReference{
+			Namespace:  cluster.Namespace,
+			Name:       randomString(),
+			Kind:       libsveltosv1beta1.SveltosClusterKind,
+			APIVersion: libsveltosv1beta1.GroupVersion.String(),
+		}
+
+		key := randomString()
+		value := randomString()
+
+		classifier := &libsveltosv1beta1.Classifier{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: libsveltosv1beta1.ClassifierSpec{
+				ClassifierLabels: []libsveltosv1beta1.ClassifierLabel{
+					{Key: key, Value: value},
+				},
+			},
+			Status: libsveltosv1beta1.ClassifierStatus{
+				MachingClusterStatuses: []libsveltosv1beta1.MachingClusterStatus{
+					{ClusterRef: cluster, ManagedLabels: []string{key}},
+				},
+			},
+		}
+
+		// conflictingClassifier wants to set same label key to a different value
+		conflictingValue := randomString()
+		conflictingClassifier := &libsveltosv1beta1.Classifier{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: libsveltosv1beta1.ClassifierSpec{
+				ClassifierLabels: []libsveltosv1beta1.ClassifierLabel{
+					{Key: key, Value: conflictingValue},
+				},
+			},
+		}
+
+		initObjects := []client.Object{classifier, conflictingClassifier,
+			getClassifierReport(classifier.Name, &cluster, true),
+			getClassifierReport(classifier.Name, &otherCluster, false),
+			getClassifierReport(conflictingClassifier.Name, &cluster, true),
+		}
+
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+		err = show.DisplayClassifiers(context.TODO(), "", "", "", show.OutputFormatTable,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+
+		/*
+			// This is an example of how the table needs to look like
+			+------------+----------------+-------+----------------+------------------------+
+			| CLASSIFIER |    CLUSTER     | MATCH | APPLIED LABELS |       CONFLICTS        |
+			+------------+----------------+-------+----------------+------------------------+
+			| gateway    | default/prod   | true  | env=prod       | env: ingress=staging   |
+			| gateway    | default/test   | false |                |                        |
+			+------------+----------------+-------+----------------+------------------------+
+		*/
+
+		lines := strings.Split(buf.String(), "\n")
+		verifyTableRow(lines, classifier.Name, fmt.Sprintf("%s/%s", cluster.Namespace, cluster.Name),
+			"true", fmt.Sprintf("%s=%s", key, value),
+			fmt.Sprintf("%s: %s=%s", key, conflictingClassifier.Name, conflictingValue))
+		verifyTableRow(lines, classifier.Name, fmt.Sprintf("%s/%s", otherCluster.Namespace, otherCluster.Name),
+			"false")
+		verifyTableRow(lines, conflictingClassifier.Name, fmt.Sprintf("%s/%s", cluster.Namespace, cluster.Name),
+			"true", fmt.Sprintf("%s: %s=%s", key, classifier.Name, value))
+	})
+})
+
+func getClassifierReport(classifierName string, cluster *corev1.ObjectReference, match bool,
+) *libsveltosv1beta1.ClassifierReport {
+
+	return &libsveltosv1beta1.ClassifierReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      randomString(),
+		},
+		Spec: libsveltosv1beta1.ClassifierReportSpec{
+			ClusterNamespace: cluster.Namespace,
+			ClusterName:      cluster.Name,
+			ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+			ClassifierName:   classifierName,
+			Match:            match,
+		},
+	}
+}
@@ -0,0 +1,460 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	clusterOverviewKind = "ClusterOverview"
+
+	// number of hash characters displayed in table output
+	shortHashLength = 12
+
+	clusterModePull = "pull"
+	clusterModePush = "push"
+)
+
+// clusterOverview is the result model of show cluster. It joins everything Sveltos
+// knows about a single managed cluster.
+type clusterOverview struct {
+	resultHeader `json:",inline"`
+
+	Cluster          managedCluster          `json:"cluster"`
+	ClusterSummaries []clusterSummaryFeature `json:"clusterSummaries"`
+	AddOns           []addOn                 `json:"addOns"`
+	DryRunChanges    []dryRunChange          `json:"dryRunChanges"`
+	HealthChecks     []healthCheck           `json:"healthChecks"`
+	AdminRbacs       []adminRbac             `json:"adminRbacs"`
+}
+
+// managedCluster represents the SveltosCluster/CAPI Cluster
+type managedCluster struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Ready is true when cluster API server is reachable (SveltosCluster) or
+	// control plane is initialized (CAPI Cluster)
+	Ready   bool   `json:"ready"`
+	Version string `json:"version,omitempty"`
+	// ConnectionStatus and ConnectionFailures are only reported for SveltosClusters
+	ConnectionStatus   string `json:"connectionStatus,omitempty"`
+	ConnectionFailures int    `json:"connectionFailures,omitempty"`
+	// Mode is either pull or push (Sveltos reaches the cluster with the stored kubeconfig)
+	Mode string `json:"mode,omitempty"`
+	// AgentLastReportTime is the last heartbeat of the agent of a SveltosCluster in pull mode
+	AgentLastReportTime *metav1.Time `json:"agentLastReportTime,omitempty"`
+	// Phase is the provisioning phase of a CAPI Cluster
+	Phase          string            `json:"phase,omitempty"`
+	Paused         bool              `json:"paused"`
+	FailureMessage string            `json:"failureMessage,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+}
+
+// clusterSummaryFeature is the status of one feature (helm, resources, kustomize)
+// of a ClusterSummary
+type clusterSummaryFeature struct {
+	ClusterSummary  string                          `json:"clusterSummary"`
+	Profile         string                          `json:"profile,omitempty"`
+	FeatureID       libsveltosv1beta1.FeatureID     `json:"featureID"`
+	Status          libsveltosv1beta1.FeatureStatus `json:"status,omitempty"`
+	Hash            string                          `json:"hash,omitempty"`
+	FailureMessage  string                          `json:"failureMessage,omitempty"`
+	LastAppliedTime *metav1.Time                    `json:"lastAppliedTime,omitempty"`
+}
+
+var (
+	genManagedClusterHeader = func() []string {
+		return []string{"KIND", "READY", "VERSION", "CONNECTION STATUS", "PAUSED", "FAILURE MESSAGE"}
+	}
+
+	genManagedClusterRow = func(c *managedCluster) []string {
+		return []string{
+			c.Kind,
+			fmt.Sprintf("%t", c.Ready),
+			c.Version,
+			c.ConnectionStatus,
+			fmt.Sprintf("%t", c.Paused),
+			c.FailureMessage,
+		}
+	}
+
+	genClusterSummaryFeatureHeader = func() []string {
+		return []string{"CLUSTERSUMMARY", "PROFILE", "FEATURE", "STATUS", "HASH", "FAILURE MESSAGE"}
+	}
+
+	genClusterSummaryFeatureRow = func(f *clusterSummaryFeature) []string {
+		hash := f.Hash
+		if len(hash) > shortHashLength {
+			hash = hash[:shortHashLength]
+		}
+		return []string{
+			f.ClusterSummary,
+			f.Profile,
+			string(f.FeatureID),
+			string(f.Status),
+			hash,
+			f.FailureMessage,
+		}
+	}
+)
+
+func displayCluster(ctx context.Context, clusterNamespace, clusterName string,
+	clusterType libsveltosv1beta1.ClusterType, format outputFormat, logger logr.Logger) error {
+
+	overview, err := collectClusterOverview(ctx, clusterNamespace, clusterName, clusterType, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResult(format, overview)
+	}
+
+	wide := format.isWide()
+
+	sections := []struct {
+		title  string
+		header []string
+		rows   [][]string
+	}{
+		{
+			title:  fmt.Sprintf("Cluster: %s/%s", clusterNamespace, clusterName),
+			header: genManagedClusterHeader(),
+			rows:   [][]string{genManagedClusterRow(&overview.Cluster)},
+		},
+		{title: "ClusterSummaries:", header: genClusterSummaryFeatureHeader()},
+		{title: "Add-ons:", header: genAddOnsHeader(wide)},
+		{title: "DryRun changes:", header: genDryRunHeader(wide)},
+		{title: "HealthChecks:", header: genHealthCheckHeader(wide)},
+		{title: "Admin RBACs:", header: genAdminRbacHeader(wide)},
+	}
+	for i := range overview.ClusterSummaries {
+		sections[1].rows = append(sections[1].rows, genClusterSummaryFeatureRow(&overview.ClusterSummaries[i]))
+	}
+	for i := range overview.AddOns {
+		sections[2].rows = append(sections[2].rows, genAddOnsRow(&overview.AddOns[i], wide))
+	}
+	for i := range overview.DryRunChanges {
+		sections[3].rows = append(sections[3].rows, genDryRunRow(&overview.DryRunChanges[i], wide))
+	}
+	for i := range overview.HealthChecks {
+		sections[4].rows = append(sections[4].rows, genHealthCheckRow(&overview.HealthChecks[i], wide))
+	}
+	for i := range overview.AdminRbacs {
+		sections[5].rows = append(sections[5].rows, genAdminRbacRow(&overview.AdminRbacs[i], wide))
+	}
+
+	for i := range sections {
+		//nolint: forbidigo // printing results to stdout
+		fmt.Println(sections[i].title)
+		table := tablewriter.NewWriter(os.Stdout)
+		table.Header(sections[i].header)
+		for j := range sections[i].rows {
+			if err := table.Append(sections[i].rows[j]); err != nil {
+				return err
+			}
+		}
+		if err := table.Render(); err != nil {
+			return err
+		}
+		//nolint: forbidigo // printing results to stdout
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func collectClusterOverview(ctx context.Context, clusterNamespace, clusterName string,
+	clusterType libsveltosv1beta1.ClusterType, logger logr.Logger) (*clusterOverview, error) {
+
+	cluster, err := getManagedCluster(ctx, clusterNamespace, clusterName, clusterType)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Kind == libsveltosv1beta1.SveltosClusterKind {
+		clusterType = libsveltosv1beta1.ClusterTypeSveltos
+	} else {
+		clusterType = libsveltosv1beta1.ClusterTypeCapi
+	}
+
+	overview := &clusterOverview{
+		resultHeader: resultHeader{APIVersion: resultAPIVersion, Kind: clusterOverviewKind},
+		Cluster:      *cluster,
+	}
+
+	overview.ClusterSummaries, err = collectClusterSummaryFeatures(ctx, clusterNamespace, clusterName,
+		clusterType, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.AddOns, err = collectAddOnsInNamespaces(ctx, clusterNamespace, clusterName, "", logger)
+	if err != nil {
+		return nil, err
+	}
+
+	overview.DryRunChanges, err = collectDryRunInNamespaces(ctx, clusterNamespace, clusterName, "", logger)
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecks, err := collectHealthChecks(ctx, clusterNamespace, clusterName, "", logger)
+	if err != nil {
+		return nil, err
+	}
+	overview.HealthChecks = make([]healthCheck, 0, len(healthChecks))
+	for i := range healthChecks {
+		if healthChecks[i].ClusterType == clusterType {
+			overview.HealthChecks = append(overview.HealthChecks, healthChecks[i])
+		}
+	}
+
+	rbacs, err := collectAdminRbacs(ctx, clusterNamespace, clusterName, "", "", logger)
+	if err != nil {
+		return nil, err
+	}
+	overview.AdminRbacs = make([]adminRbac, 0, len(rbacs))
+	clusterInfo := fmt.Sprintf("%s:%s/%s", cluster.Kind, clusterNamespace, clusterName)
+	for i := range rbacs {
+		if rbacs[i].Cluster == clusterInfo {
+			overview.AdminRbacs = append(overview.AdminRbacs, rbacs[i])
+		}
+	}
+
+	return overview, nil
+}
+
+// getManagedCluster returns the SveltosCluster or CAPI Cluster with given namespace/name.
+// If clusterType is not set, SveltosCluster is searched first.
+func getManagedCluster(ctx context.Context, clusterNamespace, clusterName string,
+	clusterType libsveltosv1beta1.ClusterType) (*managedCluster, error) {
+
+	if clusterType == "" || clusterType == libsveltosv1beta1.ClusterTypeSveltos {
+		cluster, err := getSveltosCluster(ctx, clusterNamespace, clusterName)
+		if err == nil {
+			return cluster, nil
+		}
+		if !apierrors.IsNotFound(err) || clusterType != "" {
+			return nil, err
+		}
+	}
+
+	return getCAPICluster(ctx, clusterNamespace, clusterName)
+}
+
+func getSveltosCluster(ctx context.Context, clusterNamespace, clusterName string) (*managedCluster, error) {
+	instance := utils.GetAccessInstance()
+
+	sveltosCluster := &libsveltosv1beta1.SveltosCluster{}
+	err := instance.GetResource(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: clusterName},
+		sveltosCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSveltosManagedCluster(sveltosCluster), nil
+}
+
+func newSveltosManagedCluster(sveltosCluster *libsveltosv1beta1.SveltosCluster) *managedCluster {
+	cluster := &managedCluster{
+		Kind:               libsveltosv1beta1.SveltosClusterKind,
+		Namespace:          sveltosCluster.Namespace,
+		Name:               sveltosCluster.Name,
+		Ready:              sveltosCluster.Status.Ready,
+		Version:            sveltosCluster.Status.Version,
+		ConnectionStatus:   string(sveltosCluster.Status.ConnectionStatus),
+		ConnectionFailures: sveltosCluster.Status.ConnectionFailures,
+		Mode:               clusterModePush,
+		Paused:             sveltosCluster.Spec.Paused,
+		Labels:             sveltosCluster.Labels,
+	}
+	if sveltosCluster.Spec.PullMode {
+		cluster.Mode = clusterModePull
+		cluster.AgentLastReportTime = sveltosCluster.Status.AgentLastReportTime
+	}
+	if sveltosCluster.Status.FailureMessage != nil {
+		cluster.FailureMessage = *sveltosCluster.Status.FailureMessage
+	}
+
+	return cluster
+}
+
+func getCAPICluster(ctx context.Context, clusterNamespace, clusterName string) (*managedCluster, error) {
+	instance := utils.GetAccessInstance()
+
+	capiCluster := &clusterv1.Cluster{}
+	err := instance.GetResource(ctx, types.NamespacedName{Namespace: clusterNamespace, Name: clusterName},
+		capiCluster)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("cluster %s/%s not found", clusterNamespace, clusterName)
+		}
+		return nil, err
+	}
+
+	return newCAPIManagedCluster(capiCluster), nil
+}
+
+func newCAPIManagedCluster(capiCluster *clusterv1.Cluster) *managedCluster {
+	cluster := &managedCluster{
+		Kind:      clusterv1.ClusterKind,
+		Namespace: capiCluster.Namespace,
+		Name:      capiCluster.Name,
+		Version:   capiCluster.Spec.Topology.Version,
+		Mode:      clusterModePush,
+		Phase:     capiCluster.Status.Phase,
+		Labels:    capiCluster.Labels,
+	}
+	if capiCluster.Status.Initialization.ControlPlaneInitialized != nil {
+		cluster.Ready = *capiCluster.Status.Initialization.ControlPlaneInitialized
+	}
+	if capiCluster.Spec.Paused != nil {
+		cluster.Paused = *capiCluster.Spec.Paused
+	}
+	if condition := meta.FindStatusCondition(capiCluster.Status.Conditions, clusterv1.ReadyCondition); condition != nil &&
+		condition.Status != metav1.ConditionTrue {
+
+		cluster.FailureMessage = condition.Message
+	}
+
+	return cluster
+}
+
+func collectClusterSummaryFeatures(ctx context.Context, clusterNamespace, clusterName string,
+	clusterType libsveltosv1beta1.ClusterType, logger logr.Logger) ([]clusterSummaryFeature, error) {
+
+	instance := utils.GetAccessInstance()
+
+	clusterSummaries, err := instance.ListClusterSummaries(ctx, clusterNamespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]clusterSummaryFeature, 0)
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		if cs.Spec.ClusterName != clusterName || cs.Spec.ClusterType != clusterType {
+			continue
+		}
+
+		profile := ""
+		if owner, err := configv1beta1.GetProfileOwnerReference(cs); err == nil && owner != nil {
+			profile = fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+		}
+
+		for j := range cs.Status.FeatureSummaries {
+			fs := &cs.Status.FeatureSummaries[j]
+			feature := clusterSummaryFeature{
+				ClusterSummary:  cs.Name,
+				Profile:         profile,
+				FeatureID:       fs.FeatureID,
+				Status:          fs.Status,
+				Hash:            hex.EncodeToString(fs.Hash),
+				LastAppliedTime: fs.LastAppliedTime,
+			}
+			if fs.FailureMessage != nil {
+				feature.FailureMessage = *fs.FailureMessage
+			}
+			features = append(features, feature)
+		}
+	}
+
+	return features, nil
+}
+
+// Cluster displays everything Sveltos knows about a single managed cluster
+func Cluster(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show cluster [options] <cluster> [--cluster-type=<type>] [--output=<format>] [--verbose]
+
+     <cluster>                Cluster in the form namespace/name.
+     --cluster-type=<type>    Type of the cluster: sveltos or capi. If not specified, SveltosCluster
+                              is searched first, then CAPI Cluster.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show cluster command shows, in one sectioned document, cluster readiness, ClusterSummaries'
+  feature statuses, add-ons deployed, changes pending for profiles in DryRun mode, HealthChecks'
+  outcome and admin RBACs for a single managed cluster.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	clusterNamespace, clusterName, err := utils.ParseClusterNamespaceName(parsedArgs["<cluster>"].(string))
+	if err != nil {
+		return err
+	}
+
+	var clusterType libsveltosv1beta1.ClusterType
+	if passedClusterType := parsedArgs["--cluster-type"]; passedClusterType != nil {
+		clusterType, err = utils.ParseClusterType(passedClusterType.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayCluster(ctx, clusterNamespace, clusterName, clusterType, format, logger)
+}
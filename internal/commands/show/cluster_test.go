@@ -0,0 +1,147 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Cluster", func() {
+	It("show cluster joins cluster status and ClusterSummaries", func() {
+		version := "v1.33.1"
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+			},
+			Status: libsveltosv1beta1.SveltosClusterStatus{
+				Ready:            true,
+				Version:          version,
+				ConnectionStatus: libsveltosv1beta1.ConnectionHealthy,
+			},
+		}
+
+		failureMessage := randomString()
+		hash := []byte(randomString())
+		clusterSummary := &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: sveltosCluster.Namespace,
+				Name:      randomString(),
+			},
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterNamespace: sveltosCluster.Namespace,
+				ClusterName:      sveltosCluster.Name,
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+			},
+			Status: configv1beta1.ClusterSummaryStatus{
+				FeatureSummaries: []configv1beta1.FeatureSummary{
+					{
+						FeatureID:      libsveltosv1beta1.FeatureHelm,
+						Status:         libsveltosv1beta1.FeatureStatusFailed,
+						Hash:           hash,
+						FailureMessage: &failureMessage,
+					},
+				},
+			},
+		}
+
+		// ClusterSummary for a different cluster in same namespace
+		otherClusterSummary := &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: sveltosCluster.Namespace,
+				Name:      randomString(),
+			},
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterNamespace: sveltosCluster.Namespace,
+				ClusterName:      randomString(),
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+			},
+			Status: configv1beta1.ClusterSummaryStatus{
+				FeatureSummaries: []configv1beta1.FeatureSummary{
+					{FeatureID: libsveltosv1beta1.FeatureResources, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+				},
+			},
+		}
+
+		initObjects := []client.Object{sveltosCluster, clusterSummary, otherClusterSummary}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err = show.DisplayCluster(context.TODO(), sveltosCluster.Namespace, sveltosCluster.Name, "",
+			show.OutputFormatJSON, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+
+		overview := map[string]interface{}{}
+		Expect(json.Unmarshal(buf.Bytes(), &overview)).To(Succeed())
+		Expect(overview["kind"]).To(Equal("ClusterOverview"))
+
+		cluster := overview["cluster"].(map[string]interface{})
+		Expect(cluster["kind"]).To(Equal(libsveltosv1beta1.SveltosClusterKind))
+		Expect(cluster["ready"]).To(BeTrue())
+		Expect(cluster["version"]).To(Equal(version))
+
+		features := overview["clusterSummaries"].([]interface{})
+		Expect(len(features)).To(Equal(1))
+		feature := features[0].(map[string]interface{})
+		Expect(feature["clusterSummary"]).To(Equal(clusterSummary.Name))
+		Expect(feature["status"]).To(Equal(string(libsveltosv1beta1.FeatureStatusFailed)))
+		Expect(feature["failureMessage"]).To(Equal(failureMessage))
+		Expect(feature["hash"]).To(Equal(hex.EncodeToString(hash)))
+	})
+
+	It("show cluster returns an error when cluster does not exist", func() {
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		err = show.DisplayCluster(context.TODO(), randomString(), randomString(), "",
+			show.OutputFormatTable, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+	})
+})
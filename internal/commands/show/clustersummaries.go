@@ -0,0 +1,312 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	clusterSummaryListKind = "ClusterSummaryList"
+)
+
+// clusterSummaryInfo is the result model of show clustersummaries. It represents the
+// ClusterSummary of a cluster/profile pair.
+type clusterSummaryInfo struct {
+	// Cluster is the cluster => namespace/name
+	Cluster     string                        `json:"cluster"`
+	ClusterType libsveltosv1beta1.ClusterType `json:"clusterType"`
+	// Profile is the ClusterProfile/Profile owning the ClusterSummary => kind/name
+	Profile string `json:"profile,omitempty"`
+	// Name is the ClusterSummary name
+	Name string `json:"name"`
+	// Dependencies reports the status of the profile dependencies
+	Dependencies string `json:"dependencies,omitempty"`
+	// FailureMessage is the error, not related to a specific feature, met reconciling the ClusterSummary
+	FailureMessage string `json:"failureMessage,omitempty"`
+	// SuspensionReason is set when ClusterSummary reconciliation is suspended
+	SuspensionReason string `json:"suspensionReason,omitempty"`
+	// Features is the status of each feature (Resources, Helm, Kustomize)
+	Features []featureStatus `json:"features,omitempty"`
+}
+
+var (
+	genClusterSummaryHeader = func(wide bool) []string {
+		header := []string{"CLUSTER", "PROFILE", "FEATURE", "STATUS", "FAILURES", "DEPENDENCIES", "FAILURE MESSAGE"}
+		if wide {
+			header = append(header, "CLUSTERSUMMARY", "HASH", "LAST APPLIED")
+		}
+		return header
+	}
+
+	genClusterSummaryRow = func(cs *clusterSummaryInfo, f *featureStatus, wide bool) []string {
+		feature := featureStatus{}
+		if f != nil {
+			feature = *f
+		}
+
+		failures := ""
+		if feature.ConsecutiveFailures != 0 {
+			failures = strconv.FormatUint(uint64(feature.ConsecutiveFailures), 10)
+		}
+		// A failure reconciling the ClusterSummary itself is reported when the feature has none
+		failureMessage := feature.FailureMessage
+		if failureMessage == "" {
+			failureMessage = cs.FailureMessage
+		}
+		status := string(feature.Status)
+		if cs.SuspensionReason != "" {
+			status = strings.TrimSpace(fmt.Sprintf("%s (suspended: %s)", status, cs.SuspensionReason))
+		}
+
+		row := []string{
+			cs.Cluster,
+			cs.Profile,
+			string(feature.FeatureID),
+			status,
+			failures,
+			cs.Dependencies,
+			failureMessage,
+		}
+		if wide {
+			hash := feature.Hash
+			if len(hash) > shortHashLength {
+				hash = hash[:shortHashLength]
+			}
+			lastApplied := ""
+			if feature.LastAppliedTime != nil {
+				lastApplied = feature.LastAppliedTime.String()
+			}
+			row = append(row, cs.Name, hash, lastApplied)
+		}
+		return row
+	}
+)
+
+func displayClusterSummaries(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
+	failedOnly bool, format outputFormat, logger logr.Logger) error {
+
+	clusterSummaries, err := collectClusterSummaries(ctx, passedNamespace, passedCluster, passedProfile,
+		failedOnly, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, clusterSummaryListKind, clusterSummaries)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genClusterSummaryHeader(format.isWide()))
+
+	for i := range clusterSummaries {
+		cs := &clusterSummaries[i]
+		if len(cs.Features) == 0 {
+			if err := table.Append(genClusterSummaryRow(cs, nil, format.isWide())); err != nil {
+				return err
+			}
+			continue
+		}
+		for j := range cs.Features {
+			if err := table.Append(genClusterSummaryRow(cs, &cs.Features[j], format.isWide())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return table.Render()
+}
+
+func collectClusterSummaries(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
+	failedOnly bool, logger logr.Logger) ([]clusterSummaryInfo, error) {
+
+	var profileKind, profileNamespace, profileName string
+	if passedProfile != "" {
+		var err error
+		profileKind, profileNamespace, profileName, err = utils.ParseProfileReference(passedProfile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instance := utils.GetAccessInstance()
+	clusterSummaries, err := instance.ListClusterSummaries(ctx, passedNamespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]clusterSummaryInfo, 0)
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		if passedCluster != "" && cs.Spec.ClusterName != passedCluster {
+			continue
+		}
+
+		owner, err := configv1beta1.GetProfileOwnerReference(cs)
+		if err != nil {
+			owner = nil
+		}
+		if profileKind != "" {
+			if owner == nil || owner.Kind != profileKind || owner.Name != profileName {
+				continue
+			}
+			// Profiles only create ClusterSummaries in their own namespace
+			if profileKind == configv1beta1.ProfileKind && cs.Namespace != profileNamespace {
+				continue
+			}
+		}
+
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering ClusterSummary %s/%s", cs.Namespace, cs.Name))
+		info := getClusterSummaryInfo(cs, owner)
+		if failedOnly {
+			info.Features = getFailedFeatures(info.Features)
+			if len(info.Features) == 0 && info.FailureMessage == "" {
+				continue
+			}
+		}
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+func getClusterSummaryInfo(cs *configv1beta1.ClusterSummary, owner *metav1.OwnerReference) clusterSummaryInfo {
+	info := clusterSummaryInfo{
+		Cluster:     fmt.Sprintf("%s/%s", cs.Spec.ClusterNamespace, cs.Spec.ClusterName),
+		ClusterType: cs.Spec.ClusterType,
+		Name:        cs.Name,
+	}
+	if owner != nil {
+		info.Profile = fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+	}
+	if cs.Status.Dependencies != nil {
+		info.Dependencies = *cs.Status.Dependencies
+	}
+	if cs.Status.FailureMessage != nil {
+		info.FailureMessage = *cs.Status.FailureMessage
+	}
+	if cs.Status.ReconciliationSuspended && cs.Status.SuspensionReason != nil {
+		info.SuspensionReason = *cs.Status.SuspensionReason
+	}
+
+	for i := range cs.Status.FeatureSummaries {
+		info.Features = append(info.Features, getFeatureStatus(&cs.Status.FeatureSummaries[i]))
+	}
+
+	return info
+}
+
+// getFailedFeatures returns the features which failed to deploy
+func getFailedFeatures(features []featureStatus) []featureStatus {
+	var result []featureStatus
+	for i := range features {
+		if features[i].Status == libsveltosv1beta1.FeatureStatusFailed ||
+			features[i].Status == libsveltosv1beta1.FeatureStatusFailedNonRetriable ||
+			features[i].FailureMessage != "" {
+
+			result = append(result, features[i])
+		}
+	}
+	return result
+}
+
+// ClusterSummaries displays the per feature deployment status reported in ClusterSummaries
+func ClusterSummaries(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show clustersummaries [options] [--namespace=<name>] [--cluster=<name>] [--profile=<kind/name>]
+                                   [--failed-only] [--output=<format>] [--verbose]
+
+     --namespace=<name>      Show ClusterSummaries for clusters in this namespace.
+                             If not specified all namespaces are considered.
+     --cluster=<name>        Show ClusterSummaries for cluster with name.
+                             If not specified all cluster names are considered.
+     --profile=<kind/name>   Show ClusterSummaries created for ClusterProfile/<name> or Profile/<namespace>/<name>.
+                             If not specified all ClusterProfiles/Profiles are considered.
+     --failed-only           Show only features which failed to deploy.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show clustersummaries command shows, for each cluster and ClusterProfile/Profile pair,
+  the status of each feature (Resources, Helm, Kustomize), the number of consecutive failures,
+  the last failure message and the status of the profile dependencies.
+  Wide output also shows the ClusterSummary name, the feature hash and when it was last applied.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	namespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		namespace = passedNamespace.(string)
+	}
+
+	cluster := ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		cluster = passedCluster.(string)
+	}
+
+	profile := ""
+	if passedProfile := parsedArgs["--profile"]; passedProfile != nil {
+		profile = passedProfile.(string)
+	}
+
+	failedOnly := parsedArgs["--failed-only"].(bool)
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayClusterSummaries(ctx, namespace, cluster, profile, failedOnly, format, logger)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("ClusterSummaries", func() {
+	var clusterSummary *configv1beta1.ClusterSummary
+	var otherClusterSummary *configv1beta1.ClusterSummary
+	var profileName string
+	var failureMessage string
+
+	BeforeEach(func() {
+		namespace := randomString()
+		profileName = randomString()
+		failureMessage = randomString()
+		dependencies := "All dependencies deployed"
+
+		clusterSummary = &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: configv1beta1.GroupVersion.String(),
+						Kind:       configv1beta1.ClusterProfileKind,
+						Name:       profileName,
+						UID:        "123",
+					},
+				},
+			},
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterNamespace: namespace,
+				ClusterName:      randomString(),
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+			},
+			Status: configv1beta1.ClusterSummaryStatus{
+				Dependencies: &dependencies,
+				FeatureSummaries: []configv1beta1.FeatureSummary{
+					{
+						FeatureID:           libsveltosv1beta1.FeatureHelm,
+						Status:              libsveltosv1beta1.FeatureStatusFailed,
+						ConsecutiveFailures: 3,
+						FailureMessage:      &failureMessage,
+					},
+					{
+						FeatureID: libsveltosv1beta1.FeatureResources,
+						Status:    libsveltosv1beta1.FeatureStatusProvisioned,
+					},
+				},
+			},
+		}
+
+		otherClusterSummary = &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: configv1beta1.GroupVersion.String(),
+						Kind:       configv1beta1.ClusterProfileKind,
+						Name:       randomString(),
+						UID:        "456",
+					},
+				},
+			},
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterNamespace: namespace,
+				ClusterName:      randomString(),
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+			},
+			Status: configv1beta1.ClusterSummaryStatus{
+				FeatureSummaries: []configv1beta1.FeatureSummary{
+					{FeatureID: libsveltosv1beta1.FeatureResources, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+				},
+			},
+		}
+
+		initObjects := []client.Object{clusterSummary, otherClusterSummary}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	})
+
+	display := func(profile string, failedOnly bool, format show.OutputFormat) string {
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err := show.DisplayClusterSummaries(context.TODO(), clusterSummary.Namespace, "", profile, failedOnly,
+			format, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+		return buf.String()
+	}
+
+	It("show clustersummaries displays per feature status filtered by profile", func() {
+		list := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(display(configv1beta1.ClusterProfileKind+"/"+profileName, false,
+			show.OutputFormatJSON)), &list)).To(Succeed())
+		Expect(list["kind"]).To(Equal("ClusterSummaryList"))
+
+		items := list["items"].([]interface{})
+		Expect(len(items)).To(Equal(1))
+		item := items[0].(map[string]interface{})
+		Expect(item["name"]).To(Equal(clusterSummary.Name))
+		Expect(item["profile"]).To(Equal(configv1beta1.ClusterProfileKind + "/" + profileName))
+		Expect(item["dependencies"]).To(Equal("All dependencies deployed"))
+
+		features := item["features"].([]interface{})
+		Expect(len(features)).To(Equal(2))
+		feature := features[0].(map[string]interface{})
+		Expect(feature["status"]).To(Equal(string(libsveltosv1beta1.FeatureStatusFailed)))
+		Expect(feature["consecutiveFailures"]).To(BeEquivalentTo(3))
+		Expect(feature["failureMessage"]).To(Equal(failureMessage))
+	})
+
+	It("show clustersummaries --failed-only displays only failed features", func() {
+		output := display("", true, show.OutputFormatTable)
+		Expect(output).To(ContainSubstring(failureMessage))
+		Expect(output).To(ContainSubstring(string(libsveltosv1beta1.FeatureHelm)))
+		Expect(output).ToNot(ContainSubstring(string(libsveltosv1beta1.FeatureStatusProvisioned)))
+		Expect(output).ToNot(ContainSubstring(otherClusterSummary.Spec.ClusterName))
+	})
+})
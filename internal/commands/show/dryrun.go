@@ -30,6 +30,7 @@ import (
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
@@ -37,71 +38,183 @@ import (
 	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
+const (
+	dryRunChangeListKind = "DryRunChangeList"
+)
+
+// dryRunChange is the result model of show dryrun. It represents a change that would
+// happen on a helm release or a Kubernetes resource if the ClusterProfile/Profile was
+// moved out of DryRun mode.
+type dryRunChange struct {
+	// Cluster is the cluster => namespace/name
+	Cluster string `json:"cluster"`
+	// ResourceType is either "helm release" or the resource group:kind
+	ResourceType string `json:"resourceType"`
+	// Namespace and Name are the kubernetes resource/helm release namespace/name
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// ChartVersion applies to helm releases only
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// Action represents the type of action that would take effect on the resource
+	Action string `json:"action"`
+	// Message contains details about the action. For updates, this is the full diff.
+	Message string `json:"message,omitempty"`
+	// Profile is the ClusterProfile/Profile causing the change
+	Profile string `json:"profile"`
+
+	// profileOwner is the kind:name of the ClusterReport owner. Used to display raw diffs.
+	profileOwner string
+}
+
 var (
-	// cluster represents the cluster => namespace/name
-	// resourceNamespace and resourceName is the kubernetes resource/helm release namespace/name
-	// action represents the type of action that would be take effect on the resource
-	// clusterProfileNames is the list of all ClusterProfiles causing the resource to be deployed
-	// in the cluster
-	genDryRunRow = func(cluster, resourceType, resourceNamespace, resourceName, action, message, clusterProfileName string,
-	) []string {
-		return []string{
-			cluster,
-			resourceType,
-			resourceNamespace,
-			resourceName,
-			action,
+	genDryRunHeader = func(wide bool) []string {
+		header := []string{"CLUSTER", "RESOURCE TYPE", "NAMESPACE", "NAME", "ACTION", "MESSAGE", "PROFILE"}
+		if wide {
+			header = append(header, "CHART VERSION")
+		}
+		return header
+	}
+
+	genDryRunRow = func(change *dryRunChange, wide bool) []string {
+		message := change.Message
+		if isDryRunUpdate(change) {
+			message = "use --raw-diff to see full diff"
+			if change.ResourceType == helmReleaseResourceType {
+				message = "use --raw-diff to see full diff for helm values"
+			}
+		}
+		row := []string{
+			change.Cluster,
+			change.ResourceType,
+			change.Namespace,
+			change.Name,
+			change.Action,
 			message,
-			clusterProfileName,
+			change.Profile,
 		}
+		if wide {
+			row = append(row, change.ChartVersion)
+		}
+		return row
 	}
 )
 
+const (
+	helmReleaseResourceType = "helm release"
+)
+
+// isDryRunUpdate returns true if change is an update for which a diff is available
+func isDryRunUpdate(change *dryRunChange) bool {
+	if change.ResourceType == helmReleaseResourceType {
+		return change.Action == string(configv1beta1.UpdateHelmValuesAction)
+	}
+	return change.Action == string(libsveltosv1beta1.UpdateResourceAction)
+}
+
 func displayDryRun(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
-	rawDiff bool, logger logr.Logger) error {
+	rawDiff bool, diffOptions *dryRunDiffOptions, format outputFormat, logger logr.Logger) error {
+
+	changes, err := collectDryRunInNamespaces(ctx, passedNamespace, passedCluster, passedProfile, logger)
+	if err != nil {
+		return err
+	}
+
+	// Structured output always contains the full diff
+	if format.isStructured() {
+		return printResults(format, dryRunChangeListKind, changes)
+	}
+
+	if rawDiff {
+		printDryRunDiffs(changes)
+		return nil
+	}
+
+	if diffOptions != nil {
+		return printDryRunDiffsWithFormat(os.Stdout, changes, diffOptions, logger)
+	}
 
 	table := tablewriter.NewWriter(os.Stdout)
-	table.Header("CLUSTER", "RESOURCE TYPE", "NAMESPACE", "NAME", "ACTION", "MESSAGE", "PROFILE")
+	table.Header(genDryRunHeader(format.isWide()))
 
-	if err := displayDryRunInNamespaces(ctx, passedNamespace, passedCluster,
-		passedProfile, table, rawDiff, logger); err != nil {
-		return err
+	for i := range changes {
+		if err := table.Append(genDryRunRow(&changes[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// watchDryRun displays dry run changes and keeps the table up to date as ClusterReports change
+func watchDryRun(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
+	format outputFormat, logger logr.Logger) error {
+
+	if format.isStructured() {
+		return errWatchStructuredOutput
 	}
 
-	if !rawDiff {
-		return table.Render()
+	wt := &watchedTable{
+		header:      genDryRunHeader(format.isWide()),
+		lists:       []client.ObjectList{&configv1beta1.ClusterReportList{}},
+		listOptions: namespaceListOptions(passedNamespace),
+		collect: func(ctx context.Context) ([]watchedRow, error) {
+			changes, err := collectDryRunInNamespaces(ctx, passedNamespace, passedCluster, passedProfile, logger)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([]watchedRow, len(changes))
+			for i := range changes {
+				rows[i] = watchedRow{
+					key: fmt.Sprintf("%s/%s/%s/%s/%s", changes[i].Cluster, changes[i].Profile,
+						changes[i].ResourceType, changes[i].Namespace, changes[i].Name),
+					cells: genDryRunRow(&changes[i], format.isWide()),
+				}
+			}
+			return rows, nil
+		},
 	}
 
-	return nil
+	return watchTable(ctx, wt, logger)
+}
+
+func printDryRunDiffs(changes []dryRunChange) {
+	for i := range changes {
+		change := &changes[i]
+		if change.Message != "" && isDryRunUpdate(change) {
+			//nolint: forbidigo // print diff
+			fmt.Printf("Profile: %s Cluster: %s\n%s\n", change.profileOwner, change.Cluster, change.Message)
+		}
+	}
 }
 
-func displayDryRunInNamespaces(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
-	table *tablewriter.Table, rawDiff bool, logger logr.Logger) error {
+func collectDryRunInNamespaces(ctx context.Context, passedNamespace, passedCluster, passedProfile string,
+	logger logr.Logger) ([]dryRunChange, error) {
 
 	instance := utils.GetAccessInstance()
 
 	namespaces, err := instance.ListNamespaces(ctx, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	changes := make([]dryRunChange, 0)
 	for i := range namespaces.Items {
 		ns := &namespaces.Items[i]
 		if doConsiderNamespace(ns, passedNamespace) {
 			logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering namespace: %s", ns.Name))
-			err = displayDryRunInNamespace(ctx, ns.Name, passedCluster, passedProfile,
-				table, rawDiff, logger)
+			nsChanges, err := collectDryRunInNamespace(ctx, ns.Name, passedCluster, passedProfile, logger)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			changes = append(changes, nsChanges...)
 		}
 	}
 
-	return nil
+	return changes, nil
 }
 
-func displayDryRunInNamespace(ctx context.Context, namespace, passedCluster, passedProfile string,
-	table *tablewriter.Table, rawDiff bool, logger logr.Logger) error {
+func collectDryRunInNamespace(ctx context.Context, namespace, passedCluster, passedProfile string,
+	logger logr.Logger) ([]dryRunChange, error) {
 
 	instance := utils.GetAccessInstance()
 
@@ -109,114 +222,98 @@ func displayDryRunInNamespace(ctx context.Context, namespace, passedCluster, pas
 	logger.V(logs.LogDebug).Info("Get all ClusterReports")
 	clusterReports, err := instance.ListClusterReports(ctx, namespace, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	instance.SortClusterReports(clusterReports.Items)
 
-	pattern := regexp.MustCompile("p--(.*)")
-
+	changes := make([]dryRunChange, 0)
 	for i := range clusterReports.Items {
 		cr := &clusterReports.Items[i]
-		profileLabel := cr.Labels["projectsveltos.io/cluster-profile-name"]
-
-		// TODO: find a better way to identify clusterreports created by ClusterProfile
-		// vs clusterreports created by Profile
-		var profileName string
-		// Create a regular expression pattern to match strings that start with "p--"
-		match := pattern.MatchString(cr.Name)
-		if match {
-			profileName = fmt.Sprintf("Profile/%s", profileLabel)
-		} else {
-			profileName = fmt.Sprintf("ClusterProfile/%s", profileLabel)
-		}
+		profileName := getClusterReportProfileName(cr)
 
 		if doConsiderClusterReport(cr, passedCluster) &&
 			doConsiderProfile([]string{profileName}, passedProfile) {
 
 			logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering ClusterReport: %s", cr.Name))
-			err = displayDryRunForCluster(cr, profileName, table, rawDiff)
+			crChanges, err := collectDryRunForCluster(cr, profileName)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			changes = append(changes, crChanges...)
 		}
 	}
 
-	return nil
+	return changes, nil
+}
+
+// getClusterReportProfileName returns the kind/name of the ClusterProfile/Profile
+// a ClusterReport was created for
+func getClusterReportProfileName(clusterReport *configv1beta1.ClusterReport) string {
+	profileLabel := clusterReport.Labels["projectsveltos.io/cluster-profile-name"]
+
+	// TODO: find a better way to identify clusterreports created by ClusterProfile
+	// vs clusterreports created by Profile
+	// Create a regular expression pattern to match strings that start with "p--"
+	pattern := regexp.MustCompile("p--(.*)")
+	if pattern.MatchString(clusterReport.Name) {
+		return fmt.Sprintf("Profile/%s", profileLabel)
+	}
+	return fmt.Sprintf("ClusterProfile/%s", profileLabel)
 }
 
-func displayDryRunForCluster(clusterReport *configv1beta1.ClusterReport, profileName string,
-	table *tablewriter.Table, rawDiff bool) error {
+func collectDryRunForCluster(clusterReport *configv1beta1.ClusterReport, profileName string,
+) ([]dryRunChange, error) {
 
 	clusterInfo := fmt.Sprintf("%s/%s", clusterReport.Spec.ClusterNamespace, clusterReport.Spec.ClusterName)
 	profileOwner, err := getProfileOwnerReference(clusterReport)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	owner := fmt.Sprintf("%s:%s", profileOwner.Kind, profileOwner.Name)
 
-	updateMessage := "use --raw-diff to see full diff for helm values"
+	changes := make([]dryRunChange, 0)
 	for i := range clusterReport.Status.ReleaseReports {
 		report := &clusterReport.Status.ReleaseReports[i]
-		message := report.Message
-		if report.Action == string(configv1beta1.UpdateHelmValuesAction) {
-			message = updateMessage
-		}
-		if err := table.Append(genDryRunRow(clusterInfo, "helm release", report.ReleaseNamespace, report.ReleaseName,
-			report.Action, message, profileName)); err != nil {
-			return err
-		}
-		if rawDiff && report.Message != "" && report.Action == string(configv1beta1.UpdateHelmValuesAction) {
-			//nolint: forbidigo // print diff
-			fmt.Printf("Profile: %s:%s Cluster: %s/%s\n%s\n", profileOwner.Kind, profileOwner.Name,
-				clusterReport.Spec.ClusterNamespace, clusterReport.Spec.ClusterName, report.Message)
-		}
+		changes = append(changes, dryRunChange{
+			Cluster:      clusterInfo,
+			ResourceType: helmReleaseResourceType,
+			Namespace:    report.ReleaseNamespace,
+			Name:         report.ReleaseName,
+			ChartVersion: report.ChartVersion,
+			Action:       report.Action,
+			Message:      report.Message,
+			Profile:      profileName,
+			profileOwner: owner,
+		})
 	}
 
-	updateMessage = "use --raw-diff to see full diff"
-	for i := range clusterReport.Status.ResourceReports {
-		report := &clusterReport.Status.ResourceReports[i]
-		groupKind := fmt.Sprintf("%s:%s", report.Resource.Group, report.Resource.Kind)
-		message := report.Message
-		if report.Action == string(libsveltosv1beta1.UpdateResourceAction) {
-			message = updateMessage
-		}
-		if err := table.Append(genDryRunRow(clusterInfo, groupKind, report.Resource.Namespace, report.Resource.Name,
-			report.Action, message, profileName)); err != nil {
-			return err
-		}
-		if rawDiff && report.Message != "" && report.Action == string(libsveltosv1beta1.UpdateResourceAction) {
-			//nolint: forbidigo // print diff
-			fmt.Printf("Profile: %s:%s Cluster: %s/%s\n%s\n", profileOwner.Kind, profileOwner.Name,
-				clusterReport.Spec.ClusterNamespace, clusterReport.Spec.ClusterName, report.Message)
-		}
-	}
-
-	for i := range clusterReport.Status.KustomizeResourceReports {
-		report := &clusterReport.Status.KustomizeResourceReports[i]
-		groupKind := fmt.Sprintf("%s:%s", report.Resource.Group, report.Resource.Kind)
-		message := report.Message
-		if report.Action == string(libsveltosv1beta1.UpdateResourceAction) {
-			message = updateMessage
-		}
-		if err := table.Append(genDryRunRow(clusterInfo, groupKind, report.Resource.Namespace, report.Resource.Name,
-			report.Action, message, profileName)); err != nil {
-			return err
-		}
-		if rawDiff && report.Message != "" && report.Action == string(libsveltosv1beta1.UpdateResourceAction) {
-			//nolint: forbidigo // print diff
-			fmt.Printf("Profile: %s:%s Cluster: %s/%s\n%s\n", profileOwner.Kind, profileOwner.Name,
-				clusterReport.Spec.ClusterNamespace, clusterReport.Spec.ClusterName, report.Message)
-		}
+	resourceReports := make([]libsveltosv1beta1.ResourceReport, 0)
+	resourceReports = append(resourceReports, clusterReport.Status.ResourceReports...)
+	resourceReports = append(resourceReports, clusterReport.Status.KustomizeResourceReports...)
+	for i := range resourceReports {
+		report := &resourceReports[i]
+		changes = append(changes, dryRunChange{
+			Cluster:      clusterInfo,
+			ResourceType: fmt.Sprintf("%s:%s", report.Resource.Group, report.Resource.Kind),
+			Namespace:    report.Resource.Namespace,
+			Name:         report.Resource.Name,
+			Action:       report.Action,
+			Message:      report.Message,
+			Profile:      profileName,
+			profileOwner: owner,
+		})
 	}
 
-	return nil
+	return changes, nil
 }
 
 // DryRun displays information about which Kubernetes addons would change in which cluster due
 // to a ClusterProfile currently in DryRun mode,
 func DryRun(ctx context.Context, args []string, logger logr.Logger) error {
 	doc := `Usage:
-  sveltosctl show dryrun [options] [--namespace=<name>] [--cluster=<name>] [--profile=<name>] [--raw-diff] [--verbose]
+  sveltosctl show dryrun [options] [--namespace=<name>] [--cluster=<name>] [--profile=<name>] [--raw-diff]
+                         [--diff-format=<format>] [--diff-dir=<dir>] [--output=<format>] [--watch] [--verbose]
 
      --namespace=<name>      Show which Kubernetes addons would change in clusters in this namespace.
                              If not specified all namespaces are considered.
@@ -225,13 +322,27 @@ func DryRun(ctx context.Context, args []string, logger logr.Logger) error {
      --profile=<kind/name>   Show which Kubernetes addons would change because of this clusterprofile/profile.
                              If not specified all clusterprofiles/profiles are considered.
      --raw-diff              With this flag, for each resource that would be update, full diff will be displayed.
+                             Ignored when output is json or yaml, as those always contain the full diff.
+     --diff-format=<format>  Display a diff for each resource that would be created, updated or deleted,
+                             grouped per cluster and profile. Format: unified, side-by-side or json-patch.
+                             Cannot be used along with --raw-diff.
+     --diff-dir=<dir>        Write each diff to <dir>/<cluster namespace>/<cluster name>/<profile kind>/<profile name>/
+                             instead of stdout. If --diff-format is not specified, unified is used.
 
 Options:
   -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --watch                 After displaying changes, watch ClusterReports and display updates.
+                             Cannot be used along with --raw-diff, --diff-format or --diff-dir.
      --verbose               Verbose mode. Print each step.
 
 Description:
   The show dryrun command shows information about which Kubernetes addons would change in a cluster due to ClusterProfiles in DryRun mode.
+  With --watch, the table is redrawn on every change when stdout is a terminal. Otherwise
+  only added, modified and deleted rows are printed, each prefixed by a timestamp.
+  With --diff-format, resources to update are shown as diffs. Dry run reports do not include the
+  content of resources to create or delete: those are shown with a content not available marker
+  (skipped with json-patch). Diffs are colorized when stdout is a terminal.
 `
 	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
 	if err != nil {
@@ -272,7 +383,27 @@ Description:
 
 	rawDiff := parsedArgs["--raw-diff"].(bool)
 
-	return displayDryRun(ctx, namespace, cluster, profile, rawDiff, logger)
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	diffOptions, err := getDryRunDiffOptions(parsedArgs)
+	if err != nil {
+		return err
+	}
+	if rawDiff && diffOptions != nil {
+		return fmt.Errorf("--raw-diff cannot be used along with --diff-format or --diff-dir")
+	}
+
+	if parsedArgs["--watch"].(bool) {
+		if rawDiff || diffOptions != nil {
+			return fmt.Errorf("--watch cannot be used along with --raw-diff, --diff-format or --diff-dir")
+		}
+		return watchDryRun(ctx, namespace, cluster, profile, format, logger)
+	}
+
+	return displayDryRun(ctx, namespace, cluster, profile, rawDiff, diffOptions, format, logger)
 }
 
 // getProfileOwnerReference returns the ClusterProfile/Profile owning a given ClusterReport
@@ -0,0 +1,408 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/go-logr/logr"
+	"gomodules.xyz/jsonpatch/v2"
+	"sigs.k8s.io/yaml"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+)
+
+// diffFormat is the format dry run diffs are displayed with
+type diffFormat string
+
+const (
+	diffFormatUnified    = diffFormat("unified")
+	diffFormatSideBySide = diffFormat("side-by-side")
+	diffFormatJSONPatch  = diffFormat("json-patch")
+
+	// sideBySideColumnWidth is the width of each column in side-by-side diffs
+	sideBySideColumnWidth = 60
+
+	// contentNotAvailable is displayed, in place of the diff, for creations and deletions.
+	// Sveltos only reports the content of resources that would be updated.
+	contentNotAvailable = "# content not available: dry run reports do not include the content of created or deleted resources"
+)
+
+// dryRunDiffOptions defines how dry run diffs are displayed
+type dryRunDiffOptions struct {
+	format diffFormat
+	// dir, if set, is the directory diffs are written to (one file per change) instead of stdout
+	dir string
+}
+
+// getDiffFormat returns the diff format passed with --diff-format. Empty is returned when
+// no diff format was specified.
+func getDiffFormat(parsedArgs map[string]interface{}) (diffFormat, error) {
+	passedFormat := parsedArgs["--diff-format"]
+	if passedFormat == nil {
+		return "", nil
+	}
+
+	switch format := diffFormat(passedFormat.(string)); format {
+	case diffFormatUnified, diffFormatSideBySide, diffFormatJSONPatch:
+		return format, nil
+	default:
+		return "", fmt.Errorf("possible values for diff-format are: %s, %s, %s",
+			diffFormatUnified, diffFormatSideBySide, diffFormatJSONPatch)
+	}
+}
+
+// getDryRunDiffOptions returns the diff options passed with --diff-format and --diff-dir.
+// Nil is returned when neither was specified.
+func getDryRunDiffOptions(parsedArgs map[string]interface{}) (*dryRunDiffOptions, error) {
+	format, err := getDiffFormat(parsedArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := ""
+	if passedDir := parsedArgs["--diff-dir"]; passedDir != nil {
+		dir = passedDir.(string)
+	}
+
+	if format == "" && dir == "" {
+		return nil, nil
+	}
+	if format == "" {
+		format = diffFormatUnified
+	}
+
+	return &dryRunDiffOptions{format: format, dir: dir}, nil
+}
+
+// isDryRunCreate returns true if change would create a resource or install a helm release
+func isDryRunCreate(change *dryRunChange) bool {
+	if change.ResourceType == helmReleaseResourceType {
+		return change.Action == string(configv1beta1.InstallHelmAction)
+	}
+	return change.Action == string(libsveltosv1beta1.CreateResourceAction)
+}
+
+// isDryRunDelete returns true if change would delete a resource or uninstall a helm release
+func isDryRunDelete(change *dryRunChange) bool {
+	if change.ResourceType == helmReleaseResourceType {
+		return change.Action == string(configv1beta1.UninstallHelmAction)
+	}
+	return change.Action == string(libsveltosv1beta1.DeleteResourceAction)
+}
+
+func getChangeDescription(change *dryRunChange) string {
+	if change.Namespace == "" {
+		return fmt.Sprintf("%s %s", change.ResourceType, change.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", change.ResourceType, change.Namespace, change.Name)
+}
+
+// splitDiffLines splits text in lines, ignoring the trailing new line
+func splitDiffLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// isDryRunContentMissing returns true if change is a creation or deletion whose content is
+// not reported. Sveltos leaves the message empty for those.
+func isDryRunContentMissing(change *dryRunChange) bool {
+	return (isDryRunCreate(change) || isDryRunDelete(change)) && strings.TrimSpace(change.Message) == ""
+}
+
+// genUnifiedDiff returns the unified diff for a change. Creations and deletions are
+// represented as full additions and removals when their content is reported, otherwise
+// with a content not available marker. Empty is returned for changes with no diff.
+func genUnifiedDiff(change *dryRunChange) string {
+	description := getChangeDescription(change)
+	lines := splitDiffLines(change.Message)
+
+	var sb strings.Builder
+	switch {
+	case isDryRunUpdate(change):
+		if change.Message == "" {
+			return ""
+		}
+		sb.WriteString(change.Message)
+		if !strings.HasSuffix(change.Message, "\n") {
+			sb.WriteString("\n")
+		}
+	case isDryRunContentMissing(change) && isDryRunCreate(change):
+		fmt.Fprintf(&sb, "--- /dev/null\n+++ proposed: %s\n%s\n", description, contentNotAvailable)
+	case isDryRunContentMissing(change):
+		fmt.Fprintf(&sb, "--- deployed: %s\n+++ /dev/null\n%s\n", description, contentNotAvailable)
+	case isDryRunCreate(change):
+		fmt.Fprintf(&sb, "--- /dev/null\n+++ proposed: %s\n@@ -0,0 +1,%d @@\n", description, len(lines))
+		for i := range lines {
+			fmt.Fprintf(&sb, "+%s\n", lines[i])
+		}
+	case isDryRunDelete(change):
+		fmt.Fprintf(&sb, "--- deployed: %s\n+++ /dev/null\n@@ -1,%d +0,0 @@\n", description, len(lines))
+		for i := range lines {
+			fmt.Fprintf(&sb, "-%s\n", lines[i])
+		}
+	}
+
+	return sb.String()
+}
+
+// splitUnifiedDiff reconstructs, from a unified diff, the lines of the original and
+// of the modified text
+func splitUnifiedDiff(unified string) (original, modified []string) {
+	for _, line := range splitDiffLines(unified) {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "@@"):
+			continue
+		case strings.HasPrefix(line, "-"):
+			original = append(original, line[1:])
+		case strings.HasPrefix(line, "+"):
+			modified = append(modified, line[1:])
+		default:
+			line = strings.TrimPrefix(line, " ")
+			original = append(original, line)
+			modified = append(modified, line)
+		}
+	}
+	return original, modified
+}
+
+// genSideBySideDiff converts a unified diff in a two columns diff. Lines only in the original
+// are marked with '<', lines only in the modified text with '>' and changed lines with '|'.
+func genSideBySideDiff(unified string) string {
+	var sb strings.Builder
+
+	writeRow := func(left, marker, right string) {
+		fmt.Fprintf(&sb, "%-*.*s %s %s\n", sideBySideColumnWidth, sideBySideColumnWidth, left, marker, right)
+	}
+
+	var removed, added []string
+	flush := func() {
+		for i := 0; i < len(removed) || i < len(added); i++ {
+			switch {
+			case i < len(removed) && i < len(added):
+				writeRow(removed[i], "|", added[i])
+			case i < len(removed):
+				writeRow(removed[i], "<", "")
+			default:
+				writeRow("", ">", added[i])
+			}
+		}
+		removed, added = nil, nil
+	}
+
+	for _, line := range splitDiffLines(unified) {
+		switch {
+		case strings.HasPrefix(line, "---"):
+			flush()
+			writeRow(strings.TrimSpace(strings.TrimPrefix(line, "---")), " ", "")
+		case strings.HasPrefix(line, "+++"):
+			sb.WriteString(strings.Repeat(" ", sideBySideColumnWidth+3))
+			sb.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "+++")))
+			sb.WriteString("\n")
+		case strings.HasPrefix(line, "@@"), line == contentNotAvailable:
+			flush()
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		case strings.HasPrefix(line, "-"):
+			removed = append(removed, line[1:])
+		case strings.HasPrefix(line, "+"):
+			added = append(added, line[1:])
+		default:
+			flush()
+			line = strings.TrimPrefix(line, " ")
+			writeRow(line, " ", line)
+		}
+	}
+	flush()
+
+	return sb.String()
+}
+
+// genJSONPatch returns the RFC 6902 JSON patch going from the original to the modified
+// document. It fails if the diff does not contain full YAML documents.
+func genJSONPatch(unified string) (string, error) {
+	original, modified := splitUnifiedDiff(unified)
+
+	toJSON := func(lines []string) ([]byte, error) {
+		if len(lines) == 0 {
+			return []byte("{}"), nil
+		}
+		return yaml.YAMLToJSON([]byte(strings.Join(lines, "\n")))
+	}
+
+	originalJSON, err := toJSON(original)
+	if err != nil {
+		return "", fmt.Errorf("original is not a valid document: %w", err)
+	}
+	modifiedJSON, err := toJSON(modified)
+	if err != nil {
+		return "", fmt.Errorf("modified is not a valid document: %w", err)
+	}
+
+	patch, err := jsonpatch.CreatePatch(originalJSON, modifiedJSON)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := json.MarshalIndent(patch, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(result) + "\n", nil
+}
+
+// genDiff returns the diff for a change in the requested format. Empty is returned for
+// changes with no diff.
+func genDiff(change *dryRunChange, format diffFormat) (string, error) {
+	if format == diffFormatJSONPatch && isDryRunContentMissing(change) {
+		return "", errors.New(strings.TrimPrefix(contentNotAvailable, "# "))
+	}
+
+	unified := genUnifiedDiff(change)
+	if unified == "" {
+		return "", nil
+	}
+
+	switch format {
+	case diffFormatSideBySide:
+		return genSideBySideDiff(unified), nil
+	case diffFormatJSONPatch:
+		return genJSONPatch(unified)
+	default:
+		return unified, nil
+	}
+}
+
+// colorizeDiff colors added lines in green, removed lines in red and hunks in cyan.
+// Colors are automatically disabled when stdout is not a terminal.
+func colorizeDiff(diff string, format diffFormat) string {
+	if format == diffFormatJSONPatch {
+		return diff
+	}
+
+	red := color.New(color.FgRed).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+	cyan := color.New(color.FgCyan).SprintFunc()
+
+	lines := splitDiffLines(diff)
+	for i := range lines {
+		switch {
+		case format == diffFormatSideBySide && strings.HasPrefix(lines[i], "@@"):
+			lines[i] = cyan(lines[i])
+		case format == diffFormatSideBySide && len(lines[i]) > sideBySideColumnWidth+1:
+			switch lines[i][sideBySideColumnWidth+1] {
+			case '<':
+				lines[i] = red(lines[i])
+			case '>':
+				lines[i] = green(lines[i])
+			}
+		case format == diffFormatUnified && strings.HasPrefix(lines[i], "@@"):
+			lines[i] = cyan(lines[i])
+		case format == diffFormatUnified && strings.HasPrefix(lines[i], "-"):
+			lines[i] = red(lines[i])
+		case format == diffFormatUnified && strings.HasPrefix(lines[i], "+"):
+			lines[i] = green(lines[i])
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// getDiffFileName returns the file, within dir, a diff is written to:
+// <dir>/<cluster namespace>/<cluster name>/<profile kind>/<profile name>/<resource type>_<namespace>_<name>.<ext>
+func getDiffFileName(dir string, change *dryRunChange, format diffFormat) string {
+	extension := ".diff"
+	switch format {
+	case diffFormatSideBySide:
+		extension = ".txt"
+	case diffFormatJSONPatch:
+		extension = ".json"
+	}
+
+	sanitize := func(s string) string {
+		return strings.NewReplacer(":", "_", "/", "_", " ", "-").Replace(strings.TrimPrefix(s, ":"))
+	}
+
+	namespace := change.Namespace
+	if namespace == "" {
+		namespace = "cluster-wide"
+	}
+	fileName := fmt.Sprintf("%s_%s_%s%s", sanitize(change.ResourceType), sanitize(namespace),
+		sanitize(change.Name), extension)
+
+	return filepath.Join(dir, filepath.FromSlash(change.Cluster), filepath.FromSlash(change.Profile), fileName)
+}
+
+// printDryRunDiffsWithFormat displays, grouped per cluster and profile, the diff of each change.
+// If options.dir is set, diffs are instead written to files in that directory.
+func printDryRunDiffsWithFormat(out io.Writer, changes []dryRunChange, options *dryRunDiffOptions,
+	logger logr.Logger) error {
+
+	bold := color.New(color.Bold).SprintFunc()
+
+	written := 0
+	currentGroup := ""
+	for i := range changes {
+		change := &changes[i]
+		diff, err := genDiff(change, options.format)
+		if err != nil {
+			logger.V(logs.LogInfo).Info(fmt.Sprintf("cannot generate %s diff for %s in cluster %s: %v",
+				options.format, getChangeDescription(change), change.Cluster, err))
+			continue
+		}
+		if diff == "" {
+			continue
+		}
+
+		if options.dir != "" {
+			fileName := getDiffFileName(options.dir, change, options.format)
+			if err := os.MkdirAll(filepath.Dir(fileName), 0o755); err != nil {
+				return err
+			}
+			if err := os.WriteFile(fileName, []byte(diff), 0o600); err != nil {
+				return err
+			}
+			written++
+			continue
+		}
+
+		group := fmt.Sprintf("Cluster: %s Profile: %s", change.Cluster, change.Profile)
+		if group != currentGroup {
+			currentGroup = group
+			_, _ = fmt.Fprintf(out, "%s\n", bold("### "+group))
+		}
+		_, _ = fmt.Fprintf(out, "%s\n%s", bold(fmt.Sprintf("# %s (%s)", getChangeDescription(change), change.Action)),
+			colorizeDiff(diff, options.format))
+	}
+
+	if options.dir != "" {
+		logger.V(logs.LogInfo).Info(fmt.Sprintf("%d diffs written to %s", written, options.dir))
+	}
+
+	return nil
+}
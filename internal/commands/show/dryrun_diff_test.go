@@ -0,0 +1,179 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	updatedConfigMapDiff = `--- deployed: ConfigMap default/updated
++++ proposed: ConfigMap default/updated
+@@ -1,6 +1,6 @@
+ apiVersion: v1
+ data:
+-  replicas: "1"
++  replicas: "3"
+ kind: ConfigMap
+ metadata:
+   name: updated
+`
+)
+
+var _ = Describe("DryRun diff", func() {
+	var clusterReport *configv1beta1.ClusterReport
+	var clusterProfileName string
+
+	BeforeEach(func() {
+		ns := namePrefix + randomString()
+		clusterProfileName = randomString()
+
+		clusterReport = &configv1beta1.ClusterReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      randomString(),
+				Labels: map[string]string{
+					"projectsveltos.io/cluster-profile-name": clusterProfileName,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						Kind:       configv1beta1.ClusterProfileKind,
+						Name:       clusterProfileName,
+						APIVersion: configv1beta1.GroupVersion.String(),
+					},
+				},
+			},
+			Spec: configv1beta1.ClusterReportSpec{
+				ClusterNamespace: ns,
+				ClusterName:      randomString(),
+			},
+			Status: configv1beta1.ClusterReportStatus{
+				ResourceReports: []libsveltosv1beta1.ResourceReport{
+					{
+						Resource: libsveltosv1beta1.Resource{Kind: "ConfigMap", Namespace: "default", Name: "created"},
+						// Sveltos does not report the content of resources to create or delete
+						Action: string(libsveltosv1beta1.CreateResourceAction),
+					},
+					{
+						Resource: libsveltosv1beta1.Resource{Kind: "ConfigMap", Namespace: "default", Name: "deleted"},
+						Action:   string(libsveltosv1beta1.DeleteResourceAction),
+					},
+					{
+						Resource: libsveltosv1beta1.Resource{Kind: "ConfigMap", Namespace: "default", Name: "updated"},
+						Action:   string(libsveltosv1beta1.UpdateResourceAction),
+						Message:  updatedConfigMapDiff,
+					},
+					{
+						Resource: libsveltosv1beta1.Resource{Kind: "ConfigMap", Namespace: "default", Name: "unchanged"},
+						Action:   string(libsveltosv1beta1.NoResourceAction),
+					},
+				},
+			},
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		ns1 := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns1, clusterReport).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	})
+
+	It("unified diff marks creations and deletions as content not available grouped per cluster and profile", func() {
+		output := displayDryRunDiff(show.NewDryRunDiffOptions("unified", ""))
+
+		Expect(output).To(ContainSubstring(fmt.Sprintf("### Cluster: %s/%s Profile: ClusterProfile/%s",
+			clusterReport.Spec.ClusterNamespace, clusterReport.Spec.ClusterName, clusterProfileName)))
+		Expect(output).To(ContainSubstring("# :ConfigMap default/created (Create)"))
+		Expect(output).To(ContainSubstring("--- /dev/null"))
+		Expect(output).To(ContainSubstring("# :ConfigMap default/deleted (Delete)"))
+		Expect(output).To(ContainSubstring("+++ /dev/null"))
+		Expect(output).To(ContainSubstring("# content not available"))
+		Expect(output).ToNot(ContainSubstring("@@ -0,0"))
+		Expect(output).ToNot(ContainSubstring("+0,0 @@"))
+		Expect(output).To(ContainSubstring(`+  replicas: "3"`))
+		Expect(output).ToNot(ContainSubstring("unchanged"))
+	})
+
+	It("side-by-side diff pairs removed and added lines", func() {
+		output := displayDryRunDiff(show.NewDryRunDiffOptions("side-by-side", ""))
+
+		Expect(output).To(MatchRegexp(`replicas: "1"\s+\| +replicas: "3"`))
+		Expect(output).To(MatchRegexp(`(?m)^# content not available`))
+	})
+
+	It("json-patch diff contains the RFC 6902 operations", func() {
+		output := displayDryRunDiff(show.NewDryRunDiffOptions("json-patch", ""))
+
+		Expect(output).To(ContainSubstring(`"op": "replace"`))
+		Expect(output).To(ContainSubstring(`"path": "/data/replicas"`))
+		// No patch can be generated for creations and deletions
+		Expect(output).ToNot(ContainSubstring("created"))
+		Expect(output).ToNot(ContainSubstring("deleted"))
+	})
+
+	It("diff-dir writes one file per change", func() {
+		dir := GinkgoT().TempDir()
+		output := displayDryRunDiff(show.NewDryRunDiffOptions("unified", dir))
+		Expect(output).To(BeEmpty())
+
+		profileDir := filepath.Join(dir, clusterReport.Spec.ClusterNamespace, clusterReport.Spec.ClusterName,
+			configv1beta1.ClusterProfileKind, clusterProfileName)
+		content, err := os.ReadFile(filepath.Join(profileDir, "ConfigMap_default_updated.diff"))
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal(updatedConfigMapDiff))
+
+		_, err = os.Stat(filepath.Join(profileDir, "ConfigMap_default_created.diff"))
+		Expect(err).To(BeNil())
+		_, err = os.Stat(filepath.Join(profileDir, "ConfigMap_default_unchanged.diff"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
+
+func displayDryRunDiff(options *show.DryRunDiffOptions) string {
+	old := os.Stdout // keep backup of the real stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	defer func() { os.Stdout = old }()
+
+	err := show.DisplayDryRun(context.TODO(), "", "", "", false, options,
+		show.OutputFormatTable, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+	Expect(err).To(BeNil())
+
+	w.Close()
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, r)
+	Expect(err).To(BeNil())
+	return buf.String()
+}
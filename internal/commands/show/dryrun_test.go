@@ -128,7 +128,7 @@ var _ = Describe("DryRun", func() {
 		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
 
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
-		err = show.DisplayDryRun(context.TODO(), "", "", "", false,
+		err = show.DisplayDryRun(context.TODO(), "", "", "", false, nil, show.OutputFormatTable,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 
@@ -238,7 +238,7 @@ var _ = Describe("DryRun", func() {
 		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
 
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
-		err = show.DisplayDryRun(context.TODO(), "", "", "", false,
+		err = show.DisplayDryRun(context.TODO(), "", "", "", false, nil, show.OutputFormatTable,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 
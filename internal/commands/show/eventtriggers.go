@@ -0,0 +1,304 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	corev1 "k8s.io/api/core/v1"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	eventv1beta1 "github.com/projectsveltos/event-manager/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	eventTriggerListKind = "EventTriggerList"
+
+	// Labels event-manager adds to the ClusterProfiles generated by an EventTrigger.
+	// Those are copied from the unexported constants in event-manager controllers/eventtrigger_deployer.go
+	// (github.com/projectsveltos/event-manager) and must be kept in sync with it.
+	eventTriggerNameLabel             = "eventtrigger.lib.projectsveltos.io/eventtriggername"
+	eventTriggerClusterNamespaceLabel = "eventtrigger.lib.projectsveltos.io/clusterNamespace"
+	eventTriggerClusterNameLabel      = "eventtrigger.lib.projectsveltos.io/clustername"
+)
+
+// eventTrigger is the result model of show eventtriggers. It represents an EventTrigger
+// and one of the clusters it matches.
+type eventTrigger struct {
+	// Name is the EventTrigger name
+	Name string `json:"name"`
+	// EventSource is the name of the referenced EventSource
+	EventSource string `json:"eventSource"`
+	// EventSourceFound is false when the referenced EventSource does not exist
+	EventSourceFound bool `json:"eventSourceFound"`
+	// Cluster is the matching cluster => namespace/name. Empty when EventTrigger
+	// is not matching any cluster
+	Cluster     string                        `json:"cluster,omitempty"`
+	ClusterType libsveltosv1beta1.ClusterType `json:"clusterType,omitempty"`
+	// EventReport is the EventReport received from the cluster => namespace/name
+	EventReport string `json:"eventReport,omitempty"`
+	// MatchingResources are the resources in the cluster matching the EventSource
+	MatchingResources []corev1.ObjectReference `json:"matchingResources,omitempty"`
+	// ClusterProfiles are the ClusterProfiles generated by the EventTrigger for the cluster
+	ClusterProfiles []string `json:"clusterProfiles,omitempty"`
+	// Status and FailureMessage report the EventTrigger deployment status in the cluster
+	Status         libsveltosv1beta1.SveltosFeatureStatus `json:"status,omitempty"`
+	FailureMessage string                                 `json:"failureMessage,omitempty"`
+}
+
+var (
+	genEventTriggerHeader = func(wide bool) []string {
+		header := []string{"EVENTTRIGGER", "EVENTSOURCE", "CLUSTER", "EVENTREPORT", "MATCHING RESOURCES", "CLUSTERPROFILES"}
+		if wide {
+			header = append(header, "STATUS", "FAILURE MESSAGE")
+		}
+		return header
+	}
+
+	genEventTriggerRow = func(et *eventTrigger, wide bool) []string {
+		eventSource := et.EventSource
+		if !et.EventSourceFound {
+			eventSource += " (not found)"
+		}
+		matchingResources := ""
+		if et.EventReport != "" {
+			matchingResources = strconv.Itoa(len(et.MatchingResources))
+		}
+		row := []string{
+			et.Name,
+			eventSource,
+			et.Cluster,
+			et.EventReport,
+			matchingResources,
+			strings.Join(et.ClusterProfiles, "\n"),
+		}
+		if wide {
+			row = append(row, string(et.Status), et.FailureMessage)
+		}
+		return row
+	}
+)
+
+func displayEventTriggers(ctx context.Context, passedNamespace, passedCluster, passedEventTrigger string,
+	format outputFormat, logger logr.Logger) error {
+
+	eventTriggers, err := collectEventTriggers(ctx, passedNamespace, passedCluster, passedEventTrigger, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, eventTriggerListKind, eventTriggers)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genEventTriggerHeader(format.isWide()))
+
+	for i := range eventTriggers {
+		if err := table.Append(genEventTriggerRow(&eventTriggers[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+func collectEventTriggers(ctx context.Context, passedNamespace, passedCluster, passedEventTrigger string,
+	logger logr.Logger) ([]eventTrigger, error) {
+
+	instance := utils.GetAccessInstance()
+
+	eventTriggers, err := instance.ListEventTriggers(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	eventSources, err := instance.ListEventSources(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	existingEventSources := make(map[string]bool, len(eventSources.Items))
+	for i := range eventSources.Items {
+		existingEventSources[eventSources.Items[i].Name] = true
+	}
+
+	eventReports, err := instance.ListEventReports(ctx, passedNamespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterProfiles, err := instance.ListClusterProfiles(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]eventTrigger, 0)
+	for i := range eventTriggers.Items {
+		et := &eventTriggers.Items[i]
+		if passedEventTrigger != "" && et.Name != passedEventTrigger {
+			continue
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering EventTrigger %s", et.Name))
+
+		base := eventTrigger{
+			Name:             et.Name,
+			EventSource:      et.Spec.EventSourceName,
+			EventSourceFound: existingEventSources[et.Spec.EventSourceName],
+		}
+
+		if len(et.Status.MatchingClusterRefs) == 0 {
+			if passedNamespace == "" && passedCluster == "" {
+				result = append(result, base)
+			}
+			continue
+		}
+
+		for j := range et.Status.MatchingClusterRefs {
+			cluster := &et.Status.MatchingClusterRefs[j]
+			if !doConsiderCluster(cluster, passedNamespace, passedCluster) {
+				continue
+			}
+			result = append(result, getEventTriggerInCluster(&base, et, cluster, eventReports, clusterProfiles))
+		}
+	}
+
+	return result, nil
+}
+
+func getEventTriggerInCluster(base *eventTrigger, et *eventv1beta1.EventTrigger, cluster *corev1.ObjectReference,
+	eventReports *libsveltosv1beta1.EventReportList, clusterProfiles *configv1beta1.ClusterProfileList,
+) eventTrigger {
+
+	result := *base
+	result.Cluster = fmt.Sprintf("%s/%s", cluster.Namespace, cluster.Name)
+	result.ClusterType = clusterproxy.GetClusterType(cluster)
+
+	for i := range eventReports.Items {
+		er := &eventReports.Items[i]
+		if er.Spec.ClusterNamespace == cluster.Namespace && er.Spec.ClusterName == cluster.Name &&
+			er.Spec.ClusterType == result.ClusterType && er.Spec.EventSourceName == et.Spec.EventSourceName {
+
+			result.EventReport = fmt.Sprintf("%s/%s", er.Namespace, er.Name)
+			result.MatchingResources = er.Spec.MatchingResources
+			break
+		}
+	}
+
+	for i := range clusterProfiles.Items {
+		cp := &clusterProfiles.Items[i]
+		if cp.Labels[eventTriggerNameLabel] == et.Name &&
+			cp.Labels[eventTriggerClusterNamespaceLabel] == cluster.Namespace &&
+			cp.Labels[eventTriggerClusterNameLabel] == cluster.Name {
+
+			result.ClusterProfiles = append(result.ClusterProfiles, cp.Name)
+		}
+	}
+
+	for i := range et.Status.ClusterInfo {
+		ci := &et.Status.ClusterInfo[i]
+		if ci.Cluster.Namespace == cluster.Namespace && ci.Cluster.Name == cluster.Name &&
+			ci.Cluster.Kind == cluster.Kind {
+
+			result.Status = ci.Status
+			if ci.FailureMessage != nil {
+				result.FailureMessage = *ci.FailureMessage
+			}
+			break
+		}
+	}
+
+	return result
+}
+
+// EventTriggers displays information about EventTriggers and what they caused in each matching cluster
+func EventTriggers(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show eventtriggers [options] [--name=<name>] [--namespace=<name>] [--cluster=<name>]
+                                [--output=<format>] [--verbose]
+
+     --name=<name>          Show information about EventTrigger with this name.
+                            If not specified all EventTriggers are considered.
+     --namespace=<name>     Show EventTriggers' status in clusters in this namespace.
+                            If not specified all namespaces are considered.
+     --cluster=<name>       Show EventTriggers' status in cluster with name.
+                            If not specified all cluster names are considered.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show eventtriggers command shows, for each EventTrigger, the referenced EventSource, the matching
+  clusters, the EventReport received from each cluster and the ClusterProfiles generated.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	name := ""
+	if passedName := parsedArgs["--name"]; passedName != nil {
+		name = passedName.(string)
+	}
+
+	namespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		namespace = passedNamespace.(string)
+	}
+
+	cluster := ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		cluster = passedCluster.(string)
+	}
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayEventTriggers(ctx, namespace, cluster, name, format, logger)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	eventv1beta1 "github.com/projectsveltos/event-manager/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("EventTriggers", func() {
+	It("show eventtriggers displays EventSource, EventReports and generated ClusterProfiles", func() {
+		eventSource := &libsveltosv1beta1.EventSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+		}
+
+		cluster := corev1.ObjectReference{
+			Namespace:  randomString(),
+			Name:       randomString(),
+			Kind:       libsveltosv1beta1.SveltosClusterKind,
+			APIVersion: libsveltosv1beta1.GroupVersion.String(),
+		}
+
+		eventTrigger := &eventv1beta1.EventTrigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: eventv1beta1.EventTriggerSpec{
+				EventSourceName: eventSource.Name,
+			},
+			Status: eventv1beta1.EventTriggerStatus{
+				MatchingClusterRefs: []corev1.ObjectReference{cluster},
+			},
+		}
+
+		// EventTrigger referencing a not existing EventSource and matching no cluster
+		orphanEventTrigger := &eventv1beta1.EventTrigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: eventv1beta1.EventTriggerSpec{
+				EventSourceName: randomString(),
+			},
+		}
+
+		eventReport := &libsveltosv1beta1.EventReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cluster.Namespace,
+				Name:      randomString(),
+			},
+			Spec: libsveltosv1beta1.EventReportSpec{
+				ClusterNamespace: cluster.Namespace,
+				ClusterName:      cluster.Name,
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+				EventSourceName:  eventSource.Name,
+				MatchingResources: []corev1.ObjectReference{
+					{Kind: "Service", Namespace: randomString(), Name: randomString()},
+					{Kind: "Service", Namespace: randomString(), Name: randomString()},
+				},
+			},
+		}
+
+		clusterProfile := &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+				Labels: map[string]string{
+					"eventtrigger.lib.projectsveltos.io/eventtriggername": eventTrigger.Name,
+					"eventtrigger.lib.projectsveltos.io/clusterNamespace": cluster.Namespace,
+					"eventtrigger.lib.projectsveltos.io/clustername":      cluster.Name,
+				},
+			},
+		}
+
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		initObjects := []client.Object{eventSource, eventTrigger, orphanEventTrigger, eventReport, clusterProfile}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+		err = show.DisplayEventTriggers(context.TODO(), "", "", "", show.OutputFormatTable,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+
+		/*
+			// This is an example of how the table needs to look like
+			+--------------+----------------------+----------------+---------------------+--------------------+-------------------+
+			| EVENTTRIGGER |     EVENTSOURCE      |    CLUSTER     |     EVENTREPORT     | MATCHING RESOURCES |  CLUSTERPROFILES  |
+			+--------------+----------------------+----------------+---------------------+--------------------+-------------------+
+			| service-lb   | load-balancer        | default/prod   | default/sveltos--.. | 2                  | sveltos-8ric1wgh  |
+			| other        | missing (not found)  |                |                     |                    |                   |
+			+--------------+----------------------+----------------+---------------------+--------------------+-------------------+
+		*/
+
+		lines := strings.Split(buf.String(), "\n")
+		verifyTableRow(lines, eventTrigger.Name, eventSource.Name,
+			fmt.Sprintf("%s/%s", cluster.Namespace, cluster.Name),
+			fmt.Sprintf("%s/%s", eventReport.Namespace, eventReport.Name),
+			clusterProfile.Name)
+		verifyTableRow(lines, orphanEventTrigger.Name,
+			fmt.Sprintf("%s (not found)", orphanEventTrigger.Spec.EventSourceName))
+	})
+})
@@ -17,9 +17,36 @@ limitations under the License.
 package show
 
 var (
-	DisplayAddOns     = displayAddOns
-	DisplayDryRun     = displayDryRun
-	ShowUsage         = showUsage
-	DisplayAdminRbacs = displayAdminRbacs
-	DisplayResources  = displayResources
+	DisplayAddOns           = displayAddOns
+	DisplayDryRun           = displayDryRun
+	ShowUsage               = showUsage
+	DisplayAdminRbacs       = displayAdminRbacs
+	DisplayResources        = displayResources
+	DisplayEventTriggers    = displayEventTriggers
+	DisplayClassifiers      = displayClassifiers
+	DisplayHealthChecks     = displayHealthChecks
+	DisplayCluster          = displayCluster
+	DisplayProfile          = displayProfile
+	DisplayClusterSummaries = displayClusterSummaries
+	DisplayClusterInventory = displayClusterInventory
+	DisplayProfileRollouts  = displayProfileRollouts
+	WatchAddOns             = watchAddOns
+
+	GetOutputFormat = getOutputFormat
+	PrintResults    = printResults
+
+	NewDryRunDiffOptions = func(format, dir string) *dryRunDiffOptions {
+		return &dryRunDiffOptions{format: diffFormat(format), dir: dir}
+	}
+)
+
+const (
+	OutputFormatTable = outputFormatTable
+	OutputFormatWide  = outputFormatWide
+	OutputFormatJSON  = outputFormatJSON
+	OutputFormatYAML  = outputFormatYAML
 )
+
+type DryRunDiffOptions = dryRunDiffOptions
+
+type OutputFormat = outputFormat
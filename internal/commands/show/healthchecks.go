@@ -0,0 +1,314 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	healthCheckListKind = "HealthCheckList"
+)
+
+// healthCheck is the result model of show healthchecks. It represents a HealthCheck
+// and one of the clusters it evaluates.
+type healthCheck struct {
+	// Name is the HealthCheck name
+	Name string `json:"name"`
+	// Cluster is the evaluated cluster => namespace/name. Empty when no cluster
+	// has reported on this HealthCheck yet
+	Cluster     string                        `json:"cluster,omitempty"`
+	ClusterType libsveltosv1beta1.ClusterType `json:"clusterType,omitempty"`
+	// HealthCheckReport is the HealthCheckReport sent by the cluster => namespace/name
+	HealthCheckReport string `json:"healthCheckReport,omitempty"`
+	// ReportPhase is the HealthCheckReport phase
+	ReportPhase string `json:"reportPhase,omitempty"`
+	// Healthy, Progressing, Degraded and Suspended are the number of resources
+	// in each health status
+	Healthy     int `json:"healthy"`
+	Progressing int `json:"progressing"`
+	Degraded    int `json:"degraded"`
+	Suspended   int `json:"suspended"`
+	// FailingResources are the resources which are not healthy
+	FailingResources []failingResource `json:"failingResources,omitempty"`
+}
+
+// failingResource is a resource evaluated by a HealthCheck which is not healthy
+type failingResource struct {
+	Group        string                         `json:"group"`
+	Version      string                         `json:"version"`
+	Kind         string                         `json:"kind"`
+	Namespace    string                         `json:"namespace,omitempty"`
+	Name         string                         `json:"name"`
+	HealthStatus libsveltosv1beta1.HealthStatus `json:"healthStatus"`
+	Message      string                         `json:"message,omitempty"`
+}
+
+var (
+	genHealthCheckHeader = func(wide bool) []string {
+		header := []string{"HEALTHCHECK", "CLUSTER", "HEALTHY", "PROGRESSING", "DEGRADED", "SUSPENDED"}
+		if wide {
+			header = append(header, "HEALTHCHECKREPORT", "PHASE")
+		}
+		return header
+	}
+
+	genHealthCheckRow = func(hc *healthCheck, wide bool) []string {
+		counters := []string{"", "", "", ""}
+		if hc.Cluster != "" {
+			counters = []string{strconv.Itoa(hc.Healthy), strconv.Itoa(hc.Progressing),
+				strconv.Itoa(hc.Degraded), strconv.Itoa(hc.Suspended)}
+		}
+		row := append([]string{hc.Name, hc.Cluster}, counters...)
+		if wide {
+			row = append(row, hc.HealthCheckReport, hc.ReportPhase)
+		}
+		return row
+	}
+
+	genFailingResourceHeader = func() []string {
+		return []string{"HEALTHCHECK", "CLUSTER", "GVK", "NAMESPACE", "NAME", "STATUS", "MESSAGE"}
+	}
+
+	genFailingResourceRow = func(hc *healthCheck, r *failingResource) []string {
+		gvk := schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: r.Kind}
+		return []string{
+			hc.Name,
+			hc.Cluster,
+			gvk.String(),
+			r.Namespace,
+			r.Name,
+			string(r.HealthStatus),
+			r.Message,
+		}
+	}
+)
+
+func displayHealthChecks(ctx context.Context, passedNamespace, passedCluster, passedHealthCheck string,
+	failing bool, format outputFormat, logger logr.Logger) error {
+
+	healthChecks, err := collectHealthChecks(ctx, passedNamespace, passedCluster, passedHealthCheck, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, healthCheckListKind, healthChecks)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+
+	if failing {
+		table.Header(genFailingResourceHeader())
+		table.Configure(func(config *tablewriter.Config) {
+			config.Row.Merging.Mode = tw.MergeHorizontal
+		})
+		for i := range healthChecks {
+			for j := range healthChecks[i].FailingResources {
+				row := genFailingResourceRow(&healthChecks[i], &healthChecks[i].FailingResources[j])
+				if err := table.Append(row); err != nil {
+					return err
+				}
+			}
+		}
+		return table.Render()
+	}
+
+	table.Header(genHealthCheckHeader(format.isWide()))
+	for i := range healthChecks {
+		if err := table.Append(genHealthCheckRow(&healthChecks[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+func collectHealthChecks(ctx context.Context, passedNamespace, passedCluster, passedHealthCheck string,
+	logger logr.Logger) ([]healthCheck, error) {
+
+	instance := utils.GetAccessInstance()
+
+	healthChecks, err := instance.ListHealthChecks(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	healthCheckReports, err := instance.ListHealthCheckReports(ctx, passedNamespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Key: healthCheck name. Value: HealthCheckReports for the healthCheck
+	reports := make(map[string][]*libsveltosv1beta1.HealthCheckReport)
+	for i := range healthCheckReports.Items {
+		hcr := &healthCheckReports.Items[i]
+		if passedCluster != "" && hcr.Spec.ClusterName != passedCluster {
+			continue
+		}
+		reports[hcr.Spec.HealthCheckName] = append(reports[hcr.Spec.HealthCheckName], hcr)
+	}
+
+	result := make([]healthCheck, 0)
+	for i := range healthChecks.Items {
+		hc := &healthChecks.Items[i]
+		if passedHealthCheck != "" && hc.Name != passedHealthCheck {
+			continue
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering HealthCheck %s", hc.Name))
+
+		if len(reports[hc.Name]) == 0 {
+			if passedNamespace == "" && passedCluster == "" {
+				result = append(result, healthCheck{Name: hc.Name})
+			}
+			continue
+		}
+
+		for _, hcr := range reports[hc.Name] {
+			result = append(result, getHealthCheckInCluster(hc.Name, hcr))
+		}
+	}
+
+	return result, nil
+}
+
+func getHealthCheckInCluster(healthCheckName string, hcr *libsveltosv1beta1.HealthCheckReport) healthCheck {
+	result := healthCheck{
+		Name:              healthCheckName,
+		Cluster:           fmt.Sprintf("%s/%s", hcr.Spec.ClusterNamespace, hcr.Spec.ClusterName),
+		ClusterType:       hcr.Spec.ClusterType,
+		HealthCheckReport: fmt.Sprintf("%s/%s", hcr.Namespace, hcr.Name),
+	}
+	if hcr.Status.Phase != nil {
+		result.ReportPhase = string(*hcr.Status.Phase)
+	}
+
+	for i := range hcr.Spec.ResourceStatuses {
+		resourceStatus := &hcr.Spec.ResourceStatuses[i]
+		switch resourceStatus.HealthStatus {
+		case libsveltosv1beta1.HealthStatusHealthy:
+			result.Healthy++
+			continue
+		case libsveltosv1beta1.HealthStatusProgressing:
+			result.Progressing++
+		case libsveltosv1beta1.HealthStatusDegraded:
+			result.Degraded++
+		case libsveltosv1beta1.HealthStatusSuspended:
+			result.Suspended++
+		}
+
+		gvk := resourceStatus.ObjectRef.GroupVersionKind()
+		result.FailingResources = append(result.FailingResources, failingResource{
+			Group:        gvk.Group,
+			Version:      gvk.Version,
+			Kind:         gvk.Kind,
+			Namespace:    resourceStatus.ObjectRef.Namespace,
+			Name:         resourceStatus.ObjectRef.Name,
+			HealthStatus: resourceStatus.HealthStatus,
+			Message:      resourceStatus.Message,
+		})
+	}
+
+	return result
+}
+
+// HealthChecks displays information about HealthChecks and resources' health in each evaluated cluster
+func HealthChecks(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show healthchecks [options] [--name=<name>] [--namespace=<name>] [--cluster=<name>] [--failing]
+                               [--output=<format>] [--verbose]
+
+     --name=<name>          Show information about HealthCheck with this name.
+                            If not specified all HealthChecks are considered.
+     --namespace=<name>     Show HealthChecks' outcome in clusters in this namespace.
+                            If not specified all namespaces are considered.
+     --cluster=<name>       Show HealthChecks' outcome in cluster with name.
+                            If not specified all cluster names are considered.
+     --failing              If specified, resources which are not healthy are displayed along
+                            with their message instead of the per cluster summary.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show healthchecks command shows, for each HealthCheck, the clusters it evaluates and the
+  number of Healthy, Progressing, Degraded and Suspended resources in each cluster.
+  With json and yaml output, resources which are not healthy are always included.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	name := ""
+	if passedName := parsedArgs["--name"]; passedName != nil {
+		name = passedName.(string)
+	}
+
+	namespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		namespace = passedNamespace.(string)
+	}
+
+	cluster := ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		cluster = passedCluster.(string)
+	}
+
+	failing := parsedArgs["--failing"].(bool)
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayHealthChecks(ctx, namespace, cluster, name, failing, format, logger)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("HealthChecks", func() {
+	var healthCheck *libsveltosv1beta1.HealthCheck
+	var hcr *libsveltosv1beta1.HealthCheckReport
+
+	BeforeEach(func() {
+		healthCheck = &libsveltosv1beta1.HealthCheck{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+		}
+
+		hcr = &libsveltosv1beta1.HealthCheckReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      randomString(),
+				Namespace: randomString(),
+			},
+			Spec: libsveltosv1beta1.HealthCheckReportSpec{
+				ClusterNamespace: randomString(),
+				ClusterName:      randomString(),
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+				HealthCheckName:  healthCheck.Name,
+				ResourceStatuses: []libsveltosv1beta1.ResourceStatus{
+					*generateResourceStatus(libsveltosv1beta1.HealthStatusHealthy),
+					*generateResourceStatus(libsveltosv1beta1.HealthStatusHealthy),
+					*generateResourceStatus(libsveltosv1beta1.HealthStatusProgressing),
+					*generateResourceStatus(libsveltosv1beta1.HealthStatusDegraded),
+				},
+			},
+		}
+	})
+
+	It("show healthchecks displays resources' health per cluster", func() {
+		lines := displayHealthChecks(healthCheck, hcr, false)
+
+		/*
+			// This is an example of how the table needs to look like
+			+-------------+--------------+---------+-------------+----------+-----------+
+			| HEALTHCHECK |   CLUSTER    | HEALTHY | PROGRESSING | DEGRADED | SUSPENDED |
+			+-------------+--------------+---------+-------------+----------+-----------+
+			| deployments | default/prod | 2       | 1           | 1        | 0         |
+			+-------------+--------------+---------+-------------+----------+-----------+
+		*/
+		verifyTableRow(lines, healthCheck.Name,
+			fmt.Sprintf("%s/%s", hcr.Spec.ClusterNamespace, hcr.Spec.ClusterName),
+			" 2 ", " 1 ", " 0 ")
+	})
+
+	It("show healthchecks --failing displays resources which are not healthy", func() {
+		lines := displayHealthChecks(healthCheck, hcr, true)
+
+		for i := range hcr.Spec.ResourceStatuses {
+			resourceStatus := &hcr.Spec.ResourceStatuses[i]
+			if resourceStatus.HealthStatus == libsveltosv1beta1.HealthStatusHealthy {
+				for j := range lines {
+					Expect(lines[j]).ToNot(ContainSubstring(resourceStatus.ObjectRef.Name))
+				}
+				continue
+			}
+			verifyTableRow(lines, resourceStatus.ObjectRef.Namespace, resourceStatus.ObjectRef.Name,
+				string(resourceStatus.HealthStatus), resourceStatus.Message)
+		}
+	})
+})
+
+func displayHealthChecks(healthCheck *libsveltosv1beta1.HealthCheck, hcr *libsveltosv1beta1.HealthCheckReport,
+	failing bool) []string {
+
+	old := os.Stdout // keep backup of the real stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	initObjects := []client.Object{healthCheck, hcr}
+
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	err = show.DisplayHealthChecks(context.TODO(), "", "", "", failing, show.OutputFormatTable,
+		textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+	Expect(err).To(BeNil())
+
+	w.Close()
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, r)
+	Expect(err).To(BeNil())
+	os.Stdout = old
+
+	return strings.Split(buf.String(), "\n")
+}
+
+func generateResourceStatus(healthStatus libsveltosv1beta1.HealthStatus) *libsveltosv1beta1.ResourceStatus {
+	return &libsveltosv1beta1.ResourceStatus{
+		ObjectRef: corev1.ObjectReference{
+			Kind:       "Deployment",
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Namespace:  randomString(),
+			Name:       randomString(),
+		},
+		HealthStatus: healthStatus,
+		Message:      randomString(),
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// outputFormat defines how results of a show subcommand are displayed
+type outputFormat string
+
+const (
+	// outputFormatTable displays results in a table (default)
+	outputFormatTable = outputFormat("table")
+
+	// outputFormatWide displays results in a table with additional columns
+	outputFormatWide = outputFormat("wide")
+
+	// outputFormatJSON displays results as a JSON document
+	outputFormatJSON = outputFormat("json")
+
+	// outputFormatYAML displays results as a YAML document
+	outputFormatYAML = outputFormat("yaml")
+)
+
+const (
+	// resultAPIVersion is the version of the documents produced when output is json or yaml.
+	// Fields can be added to a result type without changing it. Any change removing or
+	// renaming a field must bump the version.
+	resultAPIVersion = "show.sveltosctl.projectsveltos.io/v1beta1"
+)
+
+// resultHeader identifies the type of document displayed when output is json or yaml
+type resultHeader struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+}
+
+// resultList is the document displayed when output is json or yaml and result is a list
+type resultList struct {
+	resultHeader `json:",inline"`
+	Items        interface{} `json:"items"`
+}
+
+// isStructured returns true if results must be displayed as a JSON/YAML document
+func (o outputFormat) isStructured() bool {
+	return o == outputFormatJSON || o == outputFormatYAML
+}
+
+// isWide returns true if table must contain additional columns
+func (o outputFormat) isWide() bool {
+	return o == outputFormatWide
+}
+
+// getOutputFormat returns the output format passed with --output. Table is returned
+// when no output format was specified.
+func getOutputFormat(parsedArgs map[string]interface{}) (outputFormat, error) {
+	passedOutput := parsedArgs["--output"]
+	if passedOutput == nil {
+		return outputFormatTable, nil
+	}
+
+	switch format := outputFormat(passedOutput.(string)); format {
+	case outputFormatTable, outputFormatWide, outputFormatJSON, outputFormatYAML:
+		return format, nil
+	default:
+		return "", fmt.Errorf("possible values for output are: %s, %s, %s, %s",
+			outputFormatTable, outputFormatWide, outputFormatJSON, outputFormatYAML)
+	}
+}
+
+// printResults writes items, wrapped in a resultList of the given kind, to stdout
+// in the requested format.
+func printResults(format outputFormat, kind string, items interface{}) error {
+	result := resultList{
+		resultHeader: resultHeader{APIVersion: resultAPIVersion, Kind: kind},
+		Items:        items,
+	}
+
+	return printResult(format, result)
+}
+
+// printResult writes result to stdout in the requested format. Result is expected
+// to embed a resultHeader.
+func printResult(format outputFormat, result interface{}) error {
+	var data []byte
+	var err error
+	switch format {
+	case outputFormatJSON:
+		data, err = json.MarshalIndent(result, "", "  ")
+	case outputFormatYAML:
+		data, err = yaml.Marshal(result)
+	default:
+		return fmt.Errorf("output format %s is not a structured format", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(os.Stdout, string(data))
+	return err
+}
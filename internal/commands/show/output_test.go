@@ -0,0 +1,129 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+type testResultList struct {
+	APIVersion string                   `json:"apiVersion"`
+	Kind       string                   `json:"kind"`
+	Items      []map[string]interface{} `json:"items"`
+}
+
+var _ = Describe("Output", func() {
+	It("getOutputFormat returns table when output is not set", func() {
+		format, err := show.GetOutputFormat(map[string]interface{}{})
+		Expect(err).To(BeNil())
+		Expect(format).To(Equal(show.OutputFormatTable))
+	})
+
+	It("getOutputFormat validates output format", func() {
+		format, err := show.GetOutputFormat(map[string]interface{}{"--output": "yaml"})
+		Expect(err).To(BeNil())
+		Expect(format).To(Equal(show.OutputFormatYAML))
+
+		_, err = show.GetOutputFormat(map[string]interface{}{"--output": "xml"})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("printResults fails for table format", func() {
+		Expect(show.PrintResults(show.OutputFormatTable, randomString(), []string{})).ToNot(BeNil())
+	})
+
+	It("show addons displays deployed helm charts in json and yaml", func() {
+		namespace := namePrefix + randomString()
+		clusterConfiguration := &configv1beta1.ClusterConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+
+		clusterProfileName := randomString()
+		charts := []configv1beta1.Chart{
+			*generateChart(), *generateChart(),
+		}
+		clusterConfiguration = addDeployedHelmCharts(clusterConfiguration, clusterProfileName, charts)
+
+		initObjects := []client.Object{ns, clusterConfiguration}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		for _, output := range []string{"json", "yaml"} {
+			format, err := show.GetOutputFormat(map[string]interface{}{"--output": output})
+			Expect(err).To(BeNil())
+
+			old := os.Stdout // keep backup of the real stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			err = show.DisplayAddOns(context.TODO(), "", "", "", format,
+				textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+			Expect(err).To(BeNil())
+
+			w.Close()
+			var buf bytes.Buffer
+			_, err = io.Copy(&buf, r)
+			Expect(err).To(BeNil())
+			os.Stdout = old
+
+			result := &testResultList{}
+			if format == show.OutputFormatJSON {
+				Expect(json.Unmarshal(buf.Bytes(), result)).To(Succeed())
+			} else {
+				Expect(yaml.Unmarshal(buf.Bytes(), result)).To(Succeed())
+			}
+
+			Expect(result.APIVersion).To(Equal("show.sveltosctl.projectsveltos.io/v1beta1"))
+			Expect(result.Kind).To(Equal("AddOnList"))
+			Expect(len(result.Items)).To(Equal(len(charts)))
+			for i := range result.Items {
+				Expect(result.Items[i]["resourceType"]).To(Equal("helm chart"))
+				Expect(result.Items[i]["cluster"]).To(Equal(namespace + "/" + clusterConfiguration.Name))
+				Expect(result.Items[i]["profiles"]).To(ContainElement(ContainSubstring(clusterProfileName)))
+			}
+		}
+	})
+})
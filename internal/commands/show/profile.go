@@ -0,0 +1,367 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	profileMatchListKind = "ProfileMatchList"
+
+	matchedByClusterSelector = "clusterSelector"
+	matchedByClusterRefs     = "clusterRefs"
+)
+
+// profileMatch is the result model of show profile. It explains whether a
+// ClusterProfile/Profile matches a cluster and, for matches, the deployment status.
+type profileMatch struct {
+	// Cluster is the cluster => namespace/name
+	Cluster     string `json:"cluster"`
+	ClusterKind string `json:"clusterKind"`
+	// Match is true if profile matches the cluster
+	Match bool `json:"match"`
+	// MatchedBy lists what in the profile spec causes the match (clusterSelector,
+	// clusterRefs or the name of a referenced (Cluster)Set)
+	MatchedBy []string `json:"matchedBy,omitempty"`
+	// FailedSelectorTerms lists the ClusterSelector terms the cluster labels do not satisfy
+	FailedSelectorTerms []string `json:"failedSelectorTerms,omitempty"`
+	// InProfileStatus is true if the cluster is listed in the profile Status.MatchingClusterRefs
+	InProfileStatus bool `json:"inProfileStatus"`
+	// ClusterSummary is the name of the ClusterSummary for this profile and cluster
+	ClusterSummary string `json:"clusterSummary,omitempty"`
+	// Features is the deployment status of each feature
+	Features []featureStatus `json:"features,omitempty"`
+}
+
+// featureStatus is the deployment status of a feature reported in a ClusterSummary
+type featureStatus struct {
+	FeatureID           libsveltosv1beta1.FeatureID     `json:"featureID"`
+	Status              libsveltosv1beta1.FeatureStatus `json:"status,omitempty"`
+	Hash                string                          `json:"hash,omitempty"`
+	ConsecutiveFailures uint                            `json:"consecutiveFailures,omitempty"`
+	FailureReason       string                          `json:"failureReason,omitempty"`
+	FailureMessage      string                          `json:"failureMessage,omitempty"`
+	LastAppliedTime     *metav1.Time                    `json:"lastAppliedTime,omitempty"`
+}
+
+// getFeatureStatus returns the deployment status of a feature reported in a ClusterSummary
+func getFeatureStatus(fs *configv1beta1.FeatureSummary) featureStatus {
+	feature := featureStatus{
+		FeatureID:           fs.FeatureID,
+		Status:              fs.Status,
+		Hash:                hex.EncodeToString(fs.Hash),
+		ConsecutiveFailures: fs.ConsecutiveFailures,
+		LastAppliedTime:     fs.LastAppliedTime,
+	}
+	if fs.FailureReason != nil {
+		feature.FailureReason = *fs.FailureReason
+	}
+	if fs.FailureMessage != nil {
+		feature.FailureMessage = *fs.FailureMessage
+	}
+	return feature
+}
+
+var (
+	genProfileMatchHeader = func(wide bool) []string {
+		header := []string{"CLUSTER", "MATCH", "MATCHED BY", "FAILED SELECTOR TERMS", "DEPLOYMENT STATUS"}
+		if wide {
+			header = append(header, "CLUSTER KIND", "IN PROFILE STATUS", "FAILURE MESSAGE")
+		}
+		return header
+	}
+
+	genProfileMatchRow = func(m *profileMatch, wide bool) []string {
+		deploymentStatus := make([]string, len(m.Features))
+		failureMessages := make([]string, 0)
+		for i := range m.Features {
+			deploymentStatus[i] = fmt.Sprintf("%s: %s", m.Features[i].FeatureID, m.Features[i].Status)
+			if m.Features[i].FailureMessage != "" {
+				failureMessages = append(failureMessages,
+					fmt.Sprintf("%s: %s", m.Features[i].FeatureID, m.Features[i].FailureMessage))
+			}
+		}
+		row := []string{
+			m.Cluster,
+			fmt.Sprintf("%t", m.Match),
+			strings.Join(m.MatchedBy, "\n"),
+			strings.Join(m.FailedSelectorTerms, "\n"),
+			strings.Join(deploymentStatus, "\n"),
+		}
+		if wide {
+			row = append(row, m.ClusterKind, fmt.Sprintf("%t", m.InProfileStatus),
+				strings.Join(failureMessages, "\n"))
+		}
+		return row
+	}
+)
+
+func displayProfile(ctx context.Context, kind, namespace, name string, format outputFormat,
+	logger logr.Logger) error {
+
+	profile, err := utils.GetAccessInstance().GetProfileInstance(ctx, kind, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	matches, err := collectProfileMatches(ctx, profile, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, profileMatchListKind, matches)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genProfileMatchHeader(format.isWide()))
+
+	for i := range matches {
+		if err := table.Append(genProfileMatchRow(&matches[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+func collectProfileMatches(ctx context.Context, profile *utils.ProfileInstance, logger logr.Logger,
+) ([]profileMatch, error) {
+
+	instance := utils.GetAccessInstance()
+
+	clusters, err := instance.ListManagedClusters(ctx, profile.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	setClusters, err := getSetClusters(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterSummaries, err := instance.ListClusterSummaries(ctx, profile.Namespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&profile.Spec.ClusterSelector.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ClusterSelector: %w", err)
+	}
+	requirements, _ := selector.Requirements()
+
+	matches := make([]profileMatch, len(clusters))
+	for i := range clusters {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Evaluating cluster %s:%s/%s",
+			clusters[i].GetObjectKind().GroupVersionKind().Kind, clusters[i].GetNamespace(), clusters[i].GetName()))
+		matches[i] = evaluateProfileMatch(profile, clusters[i], requirements, setClusters, clusterSummaries)
+	}
+
+	return matches, nil
+}
+
+// getSetClusters returns, for each (Cluster)Set referenced by the profile, the clusters
+// currently selected by that set. Key is the set name.
+func getSetClusters(ctx context.Context, profile *utils.ProfileInstance) (map[string][]corev1.ObjectReference, error) {
+	instance := utils.GetAccessInstance()
+
+	result := make(map[string][]corev1.ObjectReference, len(profile.Spec.SetRefs))
+	for _, setName := range profile.Spec.SetRefs {
+		var status *libsveltosv1beta1.Status
+		if profile.Kind == configv1beta1.ClusterProfileKind {
+			clusterSet := &libsveltosv1beta1.ClusterSet{}
+			err := instance.GetResource(ctx, types.NamespacedName{Name: setName}, clusterSet)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			status = &clusterSet.Status
+		} else {
+			set := &libsveltosv1beta1.Set{}
+			err := instance.GetResource(ctx, types.NamespacedName{Namespace: profile.Namespace, Name: setName}, set)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			status = &set.Status
+		}
+		result[setName] = status.SelectedClusterRefs
+	}
+
+	return result, nil
+}
+
+func isSameCluster(ref *corev1.ObjectReference, cluster client.Object) bool {
+	return ref.Namespace == cluster.GetNamespace() && ref.Name == cluster.GetName() &&
+		ref.Kind == cluster.GetObjectKind().GroupVersionKind().Kind
+}
+
+func evaluateProfileMatch(profile *utils.ProfileInstance, cluster client.Object, requirements labels.Requirements,
+	setClusters map[string][]corev1.ObjectReference, clusterSummaries *configv1beta1.ClusterSummaryList,
+) profileMatch {
+
+	kind := cluster.GetObjectKind().GroupVersionKind().Kind
+	result := profileMatch{
+		Cluster:     fmt.Sprintf("%s/%s", cluster.GetNamespace(), cluster.GetName()),
+		ClusterKind: kind,
+	}
+
+	if len(requirements) > 0 {
+		clusterLabels := labels.Set(cluster.GetLabels())
+		for i := range requirements {
+			if requirements[i].Matches(clusterLabels) {
+				continue
+			}
+			current := "label not set"
+			if value, ok := clusterLabels[requirements[i].Key()]; ok {
+				current = fmt.Sprintf("cluster has %s=%s", requirements[i].Key(), value)
+			}
+			result.FailedSelectorTerms = append(result.FailedSelectorTerms,
+				fmt.Sprintf("%s (%s)", requirements[i].String(), current))
+		}
+		if len(result.FailedSelectorTerms) == 0 {
+			result.MatchedBy = append(result.MatchedBy, matchedByClusterSelector)
+		}
+	}
+
+	for i := range profile.Spec.ClusterRefs {
+		if isSameCluster(&profile.Spec.ClusterRefs[i], cluster) {
+			result.MatchedBy = append(result.MatchedBy, matchedByClusterRefs)
+			break
+		}
+	}
+
+	for _, setName := range profile.Spec.SetRefs {
+		for i := range setClusters[setName] {
+			if isSameCluster(&setClusters[setName][i], cluster) {
+				result.MatchedBy = append(result.MatchedBy, fmt.Sprintf("set %s", setName))
+				break
+			}
+		}
+	}
+
+	result.Match = len(result.MatchedBy) > 0
+
+	for i := range profile.Status.MatchingClusterRefs {
+		if isSameCluster(&profile.Status.MatchingClusterRefs[i], cluster) {
+			result.InProfileStatus = true
+			break
+		}
+	}
+
+	clusterType := clusterproxy.GetClusterType(&corev1.ObjectReference{
+		Kind: kind, APIVersion: cluster.GetObjectKind().GroupVersionKind().GroupVersion().String()})
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		if cs.Spec.ClusterNamespace != cluster.GetNamespace() || cs.Spec.ClusterName != cluster.GetName() ||
+			cs.Spec.ClusterType != clusterType {
+
+			continue
+		}
+		owner, err := configv1beta1.GetProfileOwnerReference(cs)
+		if err != nil || owner == nil || owner.Kind != profile.Kind || owner.Name != profile.Name {
+			continue
+		}
+		result.ClusterSummary = cs.Name
+		for j := range cs.Status.FeatureSummaries {
+			result.Features = append(result.Features, getFeatureStatus(&cs.Status.FeatureSummaries[j]))
+		}
+		break
+	}
+
+	return result
+}
+
+// Profile explains which clusters a ClusterProfile/Profile matches and why
+func Profile(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show profile [options] <profile> [--output=<format>] [--verbose]
+
+     <profile>              ClusterProfile/<name> or Profile/<namespace>/<name>.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show profile command evaluates the ClusterProfile/Profile ClusterSelector, ClusterRefs and SetRefs
+  against every SveltosCluster and CAPI Cluster (for a Profile, only clusters in the Profile namespace).
+  For clusters not matching, the ClusterSelector terms cluster labels do not satisfy are displayed.
+  For matching clusters, the ClusterSummary deployment status is displayed.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	kind, namespace, name, err := utils.ParseProfileReference(parsedArgs["<profile>"].(string))
+	if err != nil {
+		return err
+	}
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayProfile(ctx, kind, namespace, name, format, logger)
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Profile", func() {
+	It("show profile explains why clusters match or not", func() {
+		namespace := randomString()
+		matchingCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+				Labels:    map[string]string{"env": "prod"},
+			},
+		}
+		nonMatchingCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+				Labels:    map[string]string{"env": "staging"},
+			},
+		}
+		referencedCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+			},
+		}
+
+		clusterProfile := &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: configv1beta1.Spec{
+				ClusterSelector: libsveltosv1beta1.Selector{
+					LabelSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"env": "prod"},
+					},
+				},
+				ClusterRefs: []corev1.ObjectReference{
+					{
+						Kind:       libsveltosv1beta1.SveltosClusterKind,
+						APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace:  referencedCluster.Namespace,
+						Name:       referencedCluster.Name,
+					},
+				},
+			},
+			Status: configv1beta1.Status{
+				MatchingClusterRefs: []corev1.ObjectReference{
+					{
+						Kind:       libsveltosv1beta1.SveltosClusterKind,
+						APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace:  matchingCluster.Namespace,
+						Name:       matchingCluster.Name,
+					},
+				},
+			},
+		}
+
+		clusterSummary := &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: configv1beta1.GroupVersion.String(),
+						Kind:       configv1beta1.ClusterProfileKind,
+						Name:       clusterProfile.Name,
+						UID:        "123",
+					},
+				},
+			},
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterNamespace: matchingCluster.Namespace,
+				ClusterName:      matchingCluster.Name,
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+			},
+			Status: configv1beta1.ClusterSummaryStatus{
+				FeatureSummaries: []configv1beta1.FeatureSummary{
+					{FeatureID: libsveltosv1beta1.FeatureHelm, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+				},
+			},
+		}
+
+		initObjects := []client.Object{matchingCluster, nonMatchingCluster, referencedCluster,
+			clusterProfile, clusterSummary}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err = show.DisplayProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			show.OutputFormatJSON, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+
+		result := map[string]interface{}{}
+		Expect(json.Unmarshal(buf.Bytes(), &result)).To(Succeed())
+		Expect(result["kind"]).To(Equal("ProfileMatchList"))
+
+		matches := map[string]map[string]interface{}{}
+		for _, item := range result["items"].([]interface{}) {
+			match := item.(map[string]interface{})
+			matches[match["cluster"].(string)] = match
+		}
+		Expect(len(matches)).To(Equal(3))
+
+		match := matches[fmt.Sprintf("%s/%s", namespace, matchingCluster.Name)]
+		Expect(match["match"]).To(BeTrue())
+		Expect(match["matchedBy"]).To(ConsistOf("clusterSelector"))
+		Expect(match["inProfileStatus"]).To(BeTrue())
+		Expect(match["clusterSummary"]).To(Equal(clusterSummary.Name))
+		features := match["features"].([]interface{})
+		Expect(len(features)).To(Equal(1))
+		Expect(features[0].(map[string]interface{})["status"]).To(
+			Equal(string(libsveltosv1beta1.FeatureStatusProvisioned)))
+
+		match = matches[fmt.Sprintf("%s/%s", namespace, nonMatchingCluster.Name)]
+		Expect(match["match"]).To(BeFalse())
+		Expect(match["inProfileStatus"]).To(BeFalse())
+		failedTerms := match["failedSelectorTerms"].([]interface{})
+		Expect(len(failedTerms)).To(Equal(1))
+		Expect(failedTerms[0]).To(ContainSubstring("env=prod"))
+		Expect(failedTerms[0]).To(ContainSubstring("cluster has env=staging"))
+
+		match = matches[fmt.Sprintf("%s/%s", namespace, referencedCluster.Name)]
+		Expect(match["match"]).To(BeTrue())
+		Expect(match["matchedBy"]).To(ConsistOf("clusterRefs"))
+		Expect(match["failedSelectorTerms"]).To(ConsistOf(ContainSubstring("label not set")))
+	})
+})
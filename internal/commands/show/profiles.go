@@ -0,0 +1,379 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	profileRolloutListKind = "ProfileRolloutList"
+
+	// defaultMaxUpdate is used when MaxUpdate is not set: all clusters are updated in parallel
+	defaultMaxUpdate = "100%"
+)
+
+// profileRollout is the result model of show profiles. It represents the rollout status
+// of a ClusterProfile/Profile across all matching clusters.
+type profileRollout struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// SyncMode is the profile sync mode (OneTime, Continuous, DryRun, ContinuousWithDriftDetection)
+	SyncMode configv1beta1.SyncMode `json:"syncMode,omitempty"`
+	Tier     int32                  `json:"tier"`
+	Paused   bool                   `json:"paused,omitempty"`
+	// MatchingClusters is the number of clusters matching the profile
+	MatchingClusters int `json:"matchingClusters"`
+	// Provisioned, Provisioning and Failed are the number of matching clusters in each state
+	Provisioned  int `json:"provisioned"`
+	Provisioning int `json:"provisioning"`
+	Failed       int `json:"failed"`
+	// MaxUpdate is the maximum number (or percentage) of clusters updated concurrently
+	MaxUpdate string `json:"maxUpdate"`
+	// Updating and Updated are the number of clusters being updated and already updated
+	// to the current profile spec
+	Updating int `json:"updating"`
+	Updated  int `json:"updated"`
+	// FailingClusters are the matching clusters where at least one feature failed
+	FailingClusters []failingCluster `json:"failingClusters,omitempty"`
+}
+
+// failingCluster is a cluster where a profile feature failed to deploy
+type failingCluster struct {
+	// Cluster is the cluster => namespace/name
+	Cluster        string                          `json:"cluster"`
+	FeatureID      libsveltosv1beta1.FeatureID     `json:"featureID"`
+	Status         libsveltosv1beta1.FeatureStatus `json:"status"`
+	FailureMessage string                          `json:"failureMessage,omitempty"`
+}
+
+var (
+	genProfileRolloutHeader = func(wide bool) []string {
+		header := []string{"PROFILE", "SYNC MODE", "TIER", "MATCHING", "PROVISIONED", "PROVISIONING", "FAILED",
+			"MAX UPDATE", "ROLLOUT"}
+		if wide {
+			header = append(header, "PAUSED")
+		}
+		return header
+	}
+
+	genProfileRolloutRow = func(p *profileRollout, wide bool) []string {
+		name := p.getName()
+		if p.Paused {
+			name += pausedSuffix
+		}
+		row := []string{
+			name,
+			string(p.SyncMode),
+			strconv.Itoa(int(p.Tier)),
+			strconv.Itoa(p.MatchingClusters),
+			strconv.Itoa(p.Provisioned),
+			strconv.Itoa(p.Provisioning),
+			strconv.Itoa(p.Failed),
+			p.MaxUpdate,
+			fmt.Sprintf("%d/%d updated, %d updating", p.Updated, p.MatchingClusters, p.Updating),
+		}
+		if wide {
+			row = append(row, fmt.Sprintf("%t", p.Paused))
+		}
+		return row
+	}
+
+	genFailingClusterHeader = func() []string {
+		return []string{"PROFILE", "CLUSTER", "FEATURE", "STATUS", "FAILURE MESSAGE"}
+	}
+
+	genFailingClusterRow = func(p *profileRollout, f *failingCluster) []string {
+		return []string{
+			p.getName(),
+			f.Cluster,
+			string(f.FeatureID),
+			string(f.Status),
+			f.FailureMessage,
+		}
+	}
+)
+
+// getName returns the profile as ClusterProfile/<name> or Profile/<namespace>/<name>
+func (p *profileRollout) getName() string {
+	if p.Namespace != "" {
+		return fmt.Sprintf("%s/%s/%s", p.Kind, p.Namespace, p.Name)
+	}
+	return fmt.Sprintf("%s/%s", p.Kind, p.Name)
+}
+
+func displayProfileRollouts(ctx context.Context, passedProfile string, failed bool, format outputFormat,
+	logger logr.Logger) error {
+
+	rollouts, err := collectProfileRollouts(ctx, passedProfile, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, profileRolloutListKind, rollouts)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+
+	if failed {
+		table.Header(genFailingClusterHeader())
+		table.Configure(func(config *tablewriter.Config) {
+			config.Row.Merging.Mode = tw.MergeHorizontal
+		})
+		for i := range rollouts {
+			for j := range rollouts[i].FailingClusters {
+				if err := table.Append(genFailingClusterRow(&rollouts[i], &rollouts[i].FailingClusters[j])); err != nil {
+					return err
+				}
+			}
+		}
+		return table.Render()
+	}
+
+	table.Header(genProfileRolloutHeader(format.isWide()))
+	for i := range rollouts {
+		if err := table.Append(genProfileRolloutRow(&rollouts[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+func collectProfileRollouts(ctx context.Context, passedProfile string, logger logr.Logger,
+) ([]profileRollout, error) {
+
+	var kind, namespace, name string
+	if passedProfile != "" {
+		var err error
+		kind, namespace, name, err = utils.ParseProfileReference(passedProfile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instance := utils.GetAccessInstance()
+
+	clusterSummaries, err := instance.ListClusterSummaries(ctx, "", logger)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]profileRollout, 0)
+
+	if kind == "" || kind == configv1beta1.ClusterProfileKind {
+		cps, err := instance.ListClusterProfiles(ctx, logger)
+		if err != nil {
+			return nil, err
+		}
+		for i := range cps.Items {
+			cp := &cps.Items[i]
+			if name != "" && cp.Name != name {
+				continue
+			}
+			logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering ClusterProfile %s", cp.Name))
+			result = append(result, getProfileRollout(configv1beta1.ClusterProfileKind, cp,
+				&cp.Spec, &cp.Status, clusterSummaries))
+		}
+	}
+
+	if kind == "" || kind == configv1beta1.ProfileKind {
+		ps, err := instance.ListProfiles(ctx, logger)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ps.Items {
+			p := &ps.Items[i]
+			if name != "" && (p.Namespace != namespace || p.Name != name) {
+				continue
+			}
+			logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering Profile %s/%s", p.Namespace, p.Name))
+			result = append(result, getProfileRollout(configv1beta1.ProfileKind, p,
+				&p.Spec, &p.Status, clusterSummaries))
+		}
+	}
+
+	return result, nil
+}
+
+func getProfileRollout(kind string, profile metav1.Object, spec *configv1beta1.Spec,
+	status *configv1beta1.Status, clusterSummaries *configv1beta1.ClusterSummaryList) profileRollout {
+
+	rollout := profileRollout{
+		Kind:             kind,
+		Namespace:        profile.GetNamespace(),
+		Name:             profile.GetName(),
+		SyncMode:         spec.SyncMode,
+		Tier:             spec.Tier,
+		Paused:           utils.IsProfilePaused(profile),
+		MatchingClusters: len(status.MatchingClusterRefs),
+		MaxUpdate:        defaultMaxUpdate,
+		Updating:         len(status.UpdatingClusters.Clusters),
+		Updated:          len(status.UpdatedClusters.Clusters),
+	}
+	if spec.MaxUpdate != nil {
+		rollout.MaxUpdate = spec.MaxUpdate.String()
+	}
+
+	for i := range status.MatchingClusterRefs {
+		ref := &status.MatchingClusterRefs[i]
+		cs := getProfileClusterSummary(kind, profile.GetName(), ref, clusterSummaries)
+		switch {
+		case cs == nil || !utils.IsClusterSummaryUpToDate(cs, spec):
+			// Feature statuses of a ClusterSummary not deploying the current spec yet are stale
+			rollout.Provisioning++
+		case addFailingFeatures(&rollout, ref, cs):
+			rollout.Failed++
+		case utils.IsClusterSummaryProvisioned(cs, spec):
+			rollout.Provisioned++
+		default:
+			rollout.Provisioning++
+		}
+	}
+
+	return rollout
+}
+
+// getProfileClusterSummary returns the ClusterSummary created by the profile for the cluster, nil if
+// none exists yet
+func getProfileClusterSummary(kind, name string, ref *corev1.ObjectReference,
+	clusterSummaries *configv1beta1.ClusterSummaryList) *configv1beta1.ClusterSummary {
+
+	clusterType := clusterproxy.GetClusterType(ref)
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		if cs.Spec.ClusterNamespace != ref.Namespace || cs.Spec.ClusterName != ref.Name ||
+			cs.Spec.ClusterType != clusterType {
+
+			continue
+		}
+		owner, err := configv1beta1.GetProfileOwnerReference(cs)
+		if err != nil || owner == nil || owner.Kind != kind || owner.Name != name {
+			continue
+		}
+		return cs
+	}
+	return nil
+}
+
+// addFailingFeatures adds to rollout the features which failed in the cluster. It returns true
+// if at least one feature failed.
+func addFailingFeatures(rollout *profileRollout, ref *corev1.ObjectReference,
+	cs *configv1beta1.ClusterSummary) bool {
+
+	failed := false
+	for i := range cs.Status.FeatureSummaries {
+		fs := &cs.Status.FeatureSummaries[i]
+		if fs.Status != libsveltosv1beta1.FeatureStatusFailed &&
+			fs.Status != libsveltosv1beta1.FeatureStatusFailedNonRetriable {
+
+			continue
+		}
+		failed = true
+		f := failingCluster{
+			Cluster:   fmt.Sprintf("%s/%s", ref.Namespace, ref.Name),
+			FeatureID: fs.FeatureID,
+			Status:    fs.Status,
+		}
+		if fs.FailureMessage != nil {
+			f.FailureMessage = *fs.FailureMessage
+		}
+		rollout.FailingClusters = append(rollout.FailingClusters, f)
+	}
+	return failed
+}
+
+// Profiles displays the rollout status of every ClusterProfile and Profile
+func Profiles(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show profiles [options] [--profile=<kind/name>] [--failed] [--output=<format>] [--verbose]
+
+     --profile=<kind/name>   Show rollout status of ClusterProfile/<name> or Profile/<namespace>/<name> only.
+                             If not specified all ClusterProfiles/Profiles are considered.
+     --failed                If specified, the clusters where a feature failed to deploy are displayed
+                             along with the failure message instead of the per profile summary.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show profiles command shows, for every ClusterProfile and Profile, the sync mode, the tier,
+  the number of matching clusters and how many of those are provisioned, provisioning or failed.
+  A cluster is provisioned when its ClusterSummary deploys the current profile spec and all
+  features are Provisioned. A ClusterSummary still deploying a previous spec is provisioning.
+  It also shows the rolling update progress: MaxUpdate and the number of clusters already updated
+  and being updated to the current profile spec.
+  With json and yaml output, failing clusters are always included.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	profile := ""
+	if passedProfile := parsedArgs["--profile"]; passedProfile != nil {
+		profile = passedProfile.(string)
+	}
+
+	failed := parsedArgs["--failed"].(bool)
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayProfileRollouts(ctx, profile, failed, format, logger)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Profiles", func() {
+	var clusterProfile *configv1beta1.ClusterProfile
+	var failureMessage string
+
+	BeforeEach(func() {
+		namespace := randomString()
+		failureMessage = randomString()
+		maxUpdate := intstr.FromString("30%")
+
+		clusterRefs := make([]corev1.ObjectReference, 4)
+		for i := range clusterRefs {
+			clusterRefs[i] = corev1.ObjectReference{
+				Namespace:  namespace,
+				Name:       randomString(),
+				Kind:       libsveltosv1beta1.SveltosClusterKind,
+				APIVersion: libsveltosv1beta1.GroupVersion.String(),
+			}
+		}
+
+		clusterProfile = &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: configv1beta1.Spec{
+				SyncMode:  configv1beta1.SyncModeContinuousWithDriftDetection,
+				Tier:      50,
+				MaxUpdate: &maxUpdate,
+				PolicyRefs: []configv1beta1.PolicyRef{
+					{Namespace: namespace, Name: randomString(), Kind: string(libsveltosv1beta1.ConfigMapReferencedResourceKind)},
+				},
+			},
+			Status: configv1beta1.Status{
+				MatchingClusterRefs: clusterRefs,
+				UpdatedClusters: configv1beta1.Clusters{
+					Clusters: []corev1.ObjectReference{clusterRefs[0]},
+				},
+				UpdatingClusters: configv1beta1.Clusters{
+					Clusters: []corev1.ObjectReference{clusterRefs[1]},
+				},
+			},
+		}
+
+		getClusterSummary := func(ref *corev1.ObjectReference, status libsveltosv1beta1.FeatureStatus,
+			message *string) *configv1beta1.ClusterSummary {
+
+			return &configv1beta1.ClusterSummary{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ref.Namespace,
+					Name:      randomString(),
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: configv1beta1.GroupVersion.String(),
+							Kind:       configv1beta1.ClusterProfileKind,
+							Name:       clusterProfile.Name,
+							UID:        "123",
+						},
+					},
+				},
+				Spec: configv1beta1.ClusterSummarySpec{
+					ClusterNamespace:   ref.Namespace,
+					ClusterName:        ref.Name,
+					ClusterType:        libsveltosv1beta1.ClusterTypeSveltos,
+					ClusterProfileSpec: *clusterProfile.Spec.DeepCopy(),
+				},
+				Status: configv1beta1.ClusterSummaryStatus{
+					FeatureSummaries: []configv1beta1.FeatureSummary{
+						{
+							FeatureID:      libsveltosv1beta1.FeatureResources,
+							Status:         status,
+							FailureMessage: message,
+						},
+					},
+				},
+			}
+		}
+
+		// Provisioned status of the fourth cluster refers to a previous profile spec
+		outdated := getClusterSummary(&clusterRefs[3], libsveltosv1beta1.FeatureStatusProvisioned, nil)
+		outdated.Spec.ClusterProfileSpec.Tier = 100
+
+		// First cluster is provisioned, second one failed and no ClusterSummary exists yet for the third one
+		initObjects := []client.Object{
+			clusterProfile,
+			getClusterSummary(&clusterRefs[0], libsveltosv1beta1.FeatureStatusProvisioned, nil),
+			getClusterSummary(&clusterRefs[1], libsveltosv1beta1.FeatureStatusFailed, &failureMessage),
+			outdated,
+		}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	})
+
+	display := func(failed bool, format show.OutputFormat) string {
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err := show.DisplayProfileRollouts(context.TODO(), configv1beta1.ClusterProfileKind+"/"+clusterProfile.Name,
+			failed, format, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+		return buf.String()
+	}
+
+	It("show profiles displays sync mode, tier and rollout status", func() {
+		list := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(display(false, show.OutputFormatJSON)), &list)).To(Succeed())
+		Expect(list["kind"]).To(Equal("ProfileRolloutList"))
+
+		items := list["items"].([]interface{})
+		Expect(len(items)).To(Equal(1))
+
+		item := items[0].(map[string]interface{})
+		Expect(item["name"]).To(Equal(clusterProfile.Name))
+		Expect(item["syncMode"]).To(Equal(string(configv1beta1.SyncModeContinuousWithDriftDetection)))
+		Expect(item["tier"]).To(BeEquivalentTo(50))
+		Expect(item["maxUpdate"]).To(Equal("30%"))
+		Expect(item["matchingClusters"]).To(BeEquivalentTo(4))
+		Expect(item["provisioned"]).To(BeEquivalentTo(1))
+		Expect(item["provisioning"]).To(BeEquivalentTo(2))
+		Expect(item["failed"]).To(BeEquivalentTo(1))
+		Expect(item["updated"]).To(BeEquivalentTo(1))
+		Expect(item["updating"]).To(BeEquivalentTo(1))
+
+		failing := item["failingClusters"].([]interface{})
+		Expect(len(failing)).To(Equal(1))
+		Expect(failing[0].(map[string]interface{})["failureMessage"]).To(Equal(failureMessage))
+	})
+
+	It("show profiles --failed displays failing clusters", func() {
+		output := display(true, show.OutputFormatTable)
+		Expect(output).To(ContainSubstring(failureMessage))
+		Expect(output).To(ContainSubstring(string(libsveltosv1beta1.FeatureResources)))
+		Expect(strings.Count(output, clusterProfile.Name)).To(Equal(1))
+	})
+})
@@ -29,6 +29,8 @@ import (
 	"github.com/olekukonko/tablewriter"
 	"github.com/olekukonko/tablewriter/tw"
 	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
 	"github.com/projectsveltos/libsveltos/lib/k8s_utils"
@@ -36,57 +38,153 @@ import (
 	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
+const (
+	resourceListKind = "ResourceList"
+)
+
+// resource is the result model of show resources. It represents a Kubernetes resource
+// collected from a managed cluster by a HealthCheck.
+type resource struct {
+	// Cluster is the managed cluster => namespace/name
+	Cluster string `json:"cluster"`
+	// Group, Version and Kind are the resource group/version/kind
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+	// Namespace and Name are the kubernetes resource namespace/name
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// HealthStatus is the resource health status as evaluated by the HealthCheck
+	HealthStatus libsveltosv1beta1.HealthStatus `json:"healthStatus"`
+	// Message is the message reported by the HealthCheck
+	Message string `json:"message,omitempty"`
+	// Object is the full resource. It is set only when --full is passed
+	Object map[string]interface{} `json:"object,omitempty"`
+
+	status *libsveltosv1beta1.ResourceStatus
+}
+
 var (
+	genResourceHeader = func(wide bool) []string {
+		header := []string{"CLUSTER", "GVK", "NAMESPACE", "NAME", "MESSAGE"}
+		if wide {
+			header = append(header, "HEALTH STATUS")
+		}
+		return header
+	}
+
 	// cluster represents the cluster => namespace/name
 	// gvk represents the resource group/version/kind
 	// resourceNamespace and resourceName is the kubernetes resource namespace/name
-	genResourceRow = func(cluster, resourceGVK, resourceNamespace, resourceName, message string) []string {
-		return []string{
-			cluster,
-			resourceGVK,
-			resourceNamespace,
-			resourceName,
-			message,
+	genResourceRow = func(r *resource, wide bool) []string {
+		gvk := schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: r.Kind}
+		row := []string{
+			r.Cluster,
+			gvk.String(),
+			r.Namespace,
+			r.Name,
+			r.Message,
 		}
+		if wide {
+			row = append(row, string(r.HealthStatus))
+		}
+		return row
 	}
 )
 
 func displayResources(ctx context.Context,
 	passedClusterNamespace, passedCluster, passedGroup, passedKind, passedNamespace string,
-	full bool, logger logr.Logger) error {
+	full bool, format outputFormat, logger logr.Logger) error {
+
+	resources, err := collectResourcesInNamespaces(ctx, passedClusterNamespace, passedCluster,
+		passedGroup, passedKind, passedNamespace, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		if full {
+			for i := range resources {
+				if err := setResourceObject(&resources[i], logger); err != nil {
+					return err
+				}
+			}
+		}
+		return printResults(format, resourceListKind, resources)
+	}
+
+	if full {
+		for i := range resources {
+			if err := printResource(&resources[i], logger); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 
 	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genResourceHeader(format.isWide()))
+	table.Configure(func(config *tablewriter.Config) {
+		config.Row.Merging.Mode = tw.MergeHorizontal
+	})
 
-	if !full {
-		table.Header("CLUSTER", "GVK", "NAMESPACE", "NAME", "MESSAGE")
-		table.Configure(func(config *tablewriter.Config) {
-			config.Row.Merging.Mode = tw.MergeHorizontal
-		})
+	for i := range resources {
+		if err := displayResource(&resources[i], format.isWide(), table); err != nil {
+			return err
+		}
 	}
 
-	if err := displayResourcesInNamespaces(ctx, passedClusterNamespace, passedCluster,
-		passedGroup, passedKind, passedNamespace, full, table, logger); err != nil {
-		return err
+	return table.Render()
+}
+
+// watchResources displays resources and keeps the table up to date as HealthCheckReports change
+func watchResources(ctx context.Context,
+	passedClusterNamespace, passedCluster, passedGroup, passedKind, passedNamespace string,
+	format outputFormat, logger logr.Logger) error {
+
+	if format.isStructured() {
+		return errWatchStructuredOutput
 	}
 
-	if !full {
-		return table.Render()
+	wt := &watchedTable{
+		header:      genResourceHeader(format.isWide()),
+		lists:       []client.ObjectList{&libsveltosv1beta1.HealthCheckReportList{}},
+		listOptions: namespaceListOptions(passedClusterNamespace),
+		collect: func(ctx context.Context) ([]watchedRow, error) {
+			resources, err := collectResourcesInNamespaces(ctx, passedClusterNamespace, passedCluster,
+				passedGroup, passedKind, passedNamespace, logger)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([]watchedRow, len(resources))
+			for i := range resources {
+				gvk := schema.GroupVersionKind{Group: resources[i].Group, Version: resources[i].Version,
+					Kind: resources[i].Kind}
+				rows[i] = watchedRow{
+					key: fmt.Sprintf("%s/%s/%s/%s", resources[i].Cluster, gvk.String(),
+						resources[i].Namespace, resources[i].Name),
+					cells: genResourceRow(&resources[i], format.isWide()),
+				}
+			}
+			return rows, nil
+		},
 	}
 
-	return nil
+	return watchTable(ctx, wt, logger)
 }
 
-func displayResourcesInNamespaces(ctx context.Context,
+func collectResourcesInNamespaces(ctx context.Context,
 	passedClusterNamespace, passedCluster, passedGroup, passedKind, passedNamespace string,
-	full bool, table *tablewriter.Table, logger logr.Logger) error {
+	logger logr.Logger) ([]resource, error) {
 
 	instance := utils.GetAccessInstance()
 
 	healthCheckReports, err := instance.ListHealthCheckReports(ctx, passedClusterNamespace, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	resources := make([]resource, 0)
 	for i := range healthCheckReports.Items {
 		hcr := &healthCheckReports.Items[i]
 		if passedCluster != "" && hcr.Spec.ClusterName != passedCluster {
@@ -94,76 +192,87 @@ func displayResourcesInNamespaces(ctx context.Context,
 		}
 		logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering healthCheckReport: %s/%s",
 			hcr.Namespace, hcr.Name))
-		err = displayResourcesInReport(hcr, passedGroup, passedKind, passedNamespace,
-			full, table, logger)
-		if err != nil {
-			return err
-		}
+		resources = append(resources,
+			collectResourcesInReport(hcr, passedGroup, passedKind, passedNamespace, logger)...)
 	}
 
-	return nil
+	return resources, nil
 }
 
-func displayResourcesInReport(healthCheckReport *libsveltosv1beta1.HealthCheckReport,
-	passedGroup, passedKind, passedNamespace string, full bool,
-	table *tablewriter.Table, logger logr.Logger) error {
+func collectResourcesInReport(healthCheckReport *libsveltosv1beta1.HealthCheckReport,
+	passedGroup, passedKind, passedNamespace string, logger logr.Logger) []resource {
 
 	logger = logger.WithValues("healtcheckreport", fmt.Sprintf("%s/%s",
 		healthCheckReport.Namespace, healthCheckReport.Name))
 
+	clusterInfo := fmt.Sprintf("%s/%s", healthCheckReport.Spec.ClusterNamespace,
+		healthCheckReport.Spec.ClusterName)
+
+	resources := make([]resource, 0)
 	for i := range healthCheckReport.Spec.ResourceStatuses {
 		resourceStatus := &healthCheckReport.Spec.ResourceStatuses[i]
 		if doConsiderResourceStatus(resourceStatus, passedGroup, passedKind, passedNamespace) {
 			logger.V(logs.LogDebug).Info("Considering resources in healthCheckReport")
-			if full {
-				err := printResource(resourceStatus, healthCheckReport.Spec.ClusterNamespace,
-					healthCheckReport.Spec.ClusterName, logger)
-				if err != nil {
-					return err
-				}
-			} else {
-				err := displayResource(resourceStatus, healthCheckReport.Spec.ClusterNamespace,
-					healthCheckReport.Spec.ClusterName, table)
-				if err != nil {
-					return err
-				}
-			}
+			gvk := resourceStatus.ObjectRef.GroupVersionKind()
+			resources = append(resources, resource{
+				Cluster:      clusterInfo,
+				Group:        gvk.Group,
+				Version:      gvk.Version,
+				Kind:         gvk.Kind,
+				Namespace:    resourceStatus.ObjectRef.Namespace,
+				Name:         resourceStatus.ObjectRef.Name,
+				HealthStatus: resourceStatus.HealthStatus,
+				Message:      resourceStatus.Message,
+				status:       resourceStatus,
+			})
 		}
 	}
 
-	return nil
+	return resources
 }
 
-func displayResource(resourceStatus *libsveltosv1beta1.ResourceStatus,
-	clusterNamespace, clusterName string, table *tablewriter.Table,
-) error {
+func displayResource(r *resource, wide bool, table *tablewriter.Table) error {
+	row := genResourceRow(r, wide)
 
-	clusterInfo := fmt.Sprintf("%s/%s", clusterNamespace, clusterName)
-	gvk := resourceStatus.ObjectRef.GroupVersionKind().String()
-	resourceNamespace := resourceStatus.ObjectRef.Namespace
-	resourceName := resourceStatus.ObjectRef.Name
-	message := resourceStatus.Message
-
-	if resourceStatus.HealthStatus != libsveltosv1beta1.HealthStatusHealthy {
+	if r.HealthStatus != libsveltosv1beta1.HealthStatusHealthy {
 		blackColor := color.New(color.FgBlack, color.Bold)
 		redColor := color.New(color.FgRed, color.Bold)
-		coloredData := []string{
-			blackColor.Sprint(clusterInfo),
-			blackColor.Sprint(gvk),
-			redColor.Sprint(resourceNamespace),
-			redColor.Sprint(resourceName),
-			blackColor.Sprint(message),
+		coloredData := make([]string, len(row))
+		for i := range row {
+			// namespace and name are highlighted
+			if i == 2 || i == 3 {
+				coloredData[i] = redColor.Sprint(row[i])
+			} else {
+				coloredData[i] = blackColor.Sprint(row[i])
+			}
 		}
 		return table.Append(coloredData)
 	}
 
-	return table.Append(genResourceRow(clusterInfo, gvk, resourceNamespace, resourceName, message))
+	return table.Append(row)
 }
 
-func printResource(resourceStatus *libsveltosv1beta1.ResourceStatus,
-	clusterNamespace, clusterName string, logger logr.Logger) error {
+// setResourceObject sets the full resource. Nothing is set if resources are not collected.
+func setResourceObject(r *resource, logger logr.Logger) error {
+	if r.status.Resource == nil {
+		logger.V(logs.LogDebug).Info("resources are not collected. Check configuration.")
+		return nil
+	}
+
+	u, err := k8s_utils.GetUnstructured(r.status.Resource)
+	if err != nil {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("failed to get resource %s:%s/%s",
+			r.Kind, r.Namespace, r.Name))
+		return err
+	}
+
+	r.Object = u.Object
+	return nil
+}
 
-	clusterInfo := fmt.Sprintf("%s/%s", clusterNamespace, clusterName)
+func printResource(r *resource, logger logr.Logger) error {
+	resourceStatus := r.status
+	clusterInfo := r.Cluster
 	gvk := resourceStatus.ObjectRef.GroupVersionKind().String()
 	resourceNamespace := resourceStatus.ObjectRef.Namespace
 	resourceName := resourceStatus.ObjectRef.Name
@@ -229,7 +338,7 @@ func doConsiderResourceStatus(resourceStatus *libsveltosv1beta1.ResourceStatus,
 func Resources(ctx context.Context, args []string, logger logr.Logger) error {
 	doc := `Usage:
   sveltosctl show resources [options] [--group=<group>] [--kind=<kind>] [--namespace=<namespace>]
-  [--cluster-namespace=<name>] [--cluster=<name>] [--full] [--verbose]
+  [--cluster-namespace=<name>] [--cluster=<name>] [--full] [--output=<format>] [--watch] [--verbose]
 
      --group=<group>              Show Kubernetes resources deployed in clusters matching this group.
                                   If not specified all groups are considered.
@@ -245,10 +354,15 @@ func Resources(ctx context.Context, args []string, logger logr.Logger) error {
 
 Options:
   -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --watch                 After displaying resources, watch HealthCheckReports and display changes.
+                             Cannot be used along with --full.
      --verbose               Verbose mode. Print each step.
 
 Description:
   The show addons command shows information about Kubernetes addons deployed in clusters.
+  With --watch, the table is redrawn on every change when stdout is a terminal. Otherwise
+  only added, modified and deleted rows are printed, each prefixed by a timestamp.
 `
 	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
 	if err != nil {
@@ -299,6 +413,18 @@ Description:
 		namespace = passedNamespace.(string)
 	}
 
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	if parsedArgs["--watch"].(bool) {
+		if full {
+			return fmt.Errorf("--watch cannot be used along with --full")
+		}
+		return watchResources(ctx, clusterNamespace, cluster, group, kind, namespace, format, logger)
+	}
+
 	return displayResources(ctx, clusterNamespace, cluster,
-		group, kind, namespace, full, logger)
+		group, kind, namespace, full, format, logger)
 }
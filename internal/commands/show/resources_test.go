@@ -90,7 +90,7 @@ var _ = Describe("Resources", func() {
 		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
 
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
-		err = show.DisplayResources(context.TODO(), "", "", "", "", "", false,
+		err = show.DisplayResources(context.TODO(), "", "", "", "", "", false, show.OutputFormatTable,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 
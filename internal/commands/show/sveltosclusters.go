@@ -0,0 +1,212 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	managedClusterListKind = "ManagedClusterList"
+)
+
+var (
+	genClusterInventoryHeader = func(wide bool) []string {
+		header := []string{"CLUSTER", "KIND", "READY", "CONNECTION STATUS", "FAILURES", "VERSION", "MODE",
+			"LAST HEARTBEAT", "PHASE", "LABELS"}
+		if wide {
+			header = append(header, "PAUSED", "FAILURE MESSAGE")
+		}
+		return header
+	}
+
+	genClusterInventoryRow = func(c *managedCluster, wide bool) []string {
+		failures := ""
+		if c.Kind == libsveltosv1beta1.SveltosClusterKind {
+			failures = strconv.Itoa(c.ConnectionFailures)
+		}
+		lastHeartbeat := ""
+		if c.AgentLastReportTime != nil {
+			lastHeartbeat = c.AgentLastReportTime.String()
+		}
+		labels := make([]string, 0, len(c.Labels))
+		for k, v := range c.Labels {
+			labels = append(labels, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(labels)
+
+		row := []string{
+			fmt.Sprintf("%s/%s", c.Namespace, c.Name),
+			c.Kind,
+			fmt.Sprintf("%t", c.Ready),
+			c.ConnectionStatus,
+			failures,
+			c.Version,
+			c.Mode,
+			lastHeartbeat,
+			c.Phase,
+			strings.Join(labels, "\n"),
+		}
+		if wide {
+			row = append(row, fmt.Sprintf("%t", c.Paused), c.FailureMessage)
+		}
+		return row
+	}
+)
+
+func displayClusterInventory(ctx context.Context, passedNamespace, passedCluster string,
+	passedClusterType libsveltosv1beta1.ClusterType, format outputFormat, logger logr.Logger) error {
+
+	clusters, err := collectClusterInventory(ctx, passedNamespace, passedCluster, passedClusterType, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, managedClusterListKind, clusters)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genClusterInventoryHeader(format.isWide()))
+
+	for i := range clusters {
+		if err := table.Append(genClusterInventoryRow(&clusters[i], format.isWide())); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+func collectClusterInventory(ctx context.Context, passedNamespace, passedCluster string,
+	passedClusterType libsveltosv1beta1.ClusterType, logger logr.Logger) ([]managedCluster, error) {
+
+	logger.V(logs.LogDebug).Info("Get all SveltosClusters and CAPI Clusters")
+	clusters, err := utils.GetAccessInstance().ListManagedClusters(ctx, passedNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]managedCluster, 0, len(clusters))
+	for i := range clusters {
+		if passedCluster != "" && clusters[i].GetName() != passedCluster {
+			continue
+		}
+
+		switch cluster := clusters[i].(type) {
+		case *libsveltosv1beta1.SveltosCluster:
+			if passedClusterType == "" || passedClusterType == libsveltosv1beta1.ClusterTypeSveltos {
+				result = append(result, *newSveltosManagedCluster(cluster))
+			}
+		case *clusterv1.Cluster:
+			if passedClusterType == "" || passedClusterType == libsveltosv1beta1.ClusterTypeCapi {
+				result = append(result, *newCAPIManagedCluster(cluster))
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// SveltosClusters displays the registration and connectivity inventory of SveltosClusters
+// and CAPI Clusters
+func SveltosClusters(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl show sveltosclusters [options] [--namespace=<name>] [--cluster=<name>] [--cluster-type=<type>]
+                                  [--output=<format>] [--verbose]
+
+     --namespace=<name>      Show clusters in this namespace.
+                             If not specified all namespaces are considered.
+     --cluster=<name>        Show cluster with name.
+                             If not specified all cluster names are considered.
+     --cluster-type=<type>   Show only clusters of this type: sveltos or capi.
+                             If not specified both SveltosClusters and CAPI Clusters are considered.
+
+Options:
+  -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The show sveltosclusters command displays the fleet inventory. For each SveltosCluster it
+  shows labels, readiness, connection status, consecutive connection failures, the Kubernetes
+  version and whether the cluster is registered in pull or push mode. For clusters in pull mode
+  the last agent heartbeat is displayed as well. CAPI Clusters are displayed alongside with
+  their provisioning phase.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	namespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		namespace = passedNamespace.(string)
+	}
+
+	cluster := ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		cluster = passedCluster.(string)
+	}
+
+	var clusterType libsveltosv1beta1.ClusterType
+	if passedClusterType := parsedArgs["--cluster-type"]; passedClusterType != nil {
+		clusterType, err = utils.ParseClusterType(passedClusterType.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return displayClusterInventory(ctx, namespace, cluster, clusterType, format, logger)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("SveltosClusters", func() {
+	It("show sveltosclusters displays SveltosClusters and CAPI Clusters inventory", func() {
+		namespace := randomString()
+		heartbeat := metav1.NewTime(time.Now().Truncate(time.Second))
+
+		pullCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+				Labels:    map[string]string{"env": "prod"},
+			},
+			Spec: libsveltosv1beta1.SveltosClusterSpec{
+				PullMode: true,
+			},
+			Status: libsveltosv1beta1.SveltosClusterStatus{
+				Ready:               true,
+				Version:             "v1.33.1",
+				ConnectionStatus:    libsveltosv1beta1.ConnectionHealthy,
+				AgentLastReportTime: &heartbeat,
+			},
+		}
+
+		pushCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+			},
+			Status: libsveltosv1beta1.SveltosClusterStatus{
+				ConnectionStatus:   libsveltosv1beta1.ConnectionDown,
+				ConnectionFailures: 4,
+			},
+		}
+
+		capiCluster := &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+			},
+			Status: clusterv1.ClusterStatus{
+				Phase: string(clusterv1.ClusterPhaseProvisioned),
+			},
+		}
+
+		initObjects := []client.Object{pullCluster, pushCluster, capiCluster}
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err = show.DisplayClusterInventory(context.TODO(), namespace, "", "", show.OutputFormatJSON,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+
+		list := map[string]interface{}{}
+		Expect(json.Unmarshal(buf.Bytes(), &list)).To(Succeed())
+		Expect(list["kind"]).To(Equal("ManagedClusterList"))
+
+		items := list["items"].([]interface{})
+		Expect(len(items)).To(Equal(3))
+
+		clusters := make(map[string]map[string]interface{})
+		for i := range items {
+			item := items[i].(map[string]interface{})
+			clusters[item["name"].(string)] = item
+		}
+
+		pull := clusters[pullCluster.Name]
+		Expect(pull["kind"]).To(Equal(libsveltosv1beta1.SveltosClusterKind))
+		Expect(pull["mode"]).To(Equal("pull"))
+		Expect(pull["ready"]).To(BeTrue())
+		Expect(pull["version"]).To(Equal("v1.33.1"))
+		Expect(pull["agentLastReportTime"]).ToNot(BeNil())
+		Expect(pull["labels"]).To(HaveKeyWithValue("env", "prod"))
+
+		push := clusters[pushCluster.Name]
+		Expect(push["mode"]).To(Equal("push"))
+		Expect(push["connectionStatus"]).To(Equal(string(libsveltosv1beta1.ConnectionDown)))
+		Expect(push["connectionFailures"]).To(BeEquivalentTo(4))
+		Expect(push).ToNot(HaveKey("agentLastReportTime"))
+
+		capi := clusters[capiCluster.Name]
+		Expect(capi["kind"]).To(Equal(clusterv1.ClusterKind))
+		Expect(capi["phase"]).To(Equal(string(clusterv1.ClusterPhaseProvisioned)))
+	})
+})
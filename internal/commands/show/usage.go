@@ -34,127 +34,173 @@ import (
 	"github.com/projectsveltos/sveltosctl/internal/utils"
 )
 
+const (
+	usageListKind = "UsageList"
+)
+
+// usage is the result model of show usage. It represents a ClusterProfile/Profile or a
+// ConfigMap/Secret referenced by at least one of those, and the clusters where its
+// content is deployed.
+type usage struct {
+	// Kind identifies the type of resource (ClusterProfile, Profile, ConfigMap, Secret)
+	Kind string `json:"kind"`
+	// Namespace and Name are the kubernetes resource namespace/name
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Clusters is the list of clusters where resource content is deployed
+	Clusters []string `json:"clusters"`
+	// Paused is true for paused ClusterProfiles/Profiles
+	Paused bool `json:"paused,omitempty"`
+}
+
 var (
-	// resourceKind indentifies the type of resource (ClusterProfile, ConfigMap, Secret)
-	// resourceNamespace and resourceName is the kubernetes resource namespace/name
-	// clusters is the list of clusters where resource content is deployed
-	genUsageRow = func(resourceKind, resourceNamespace, resourceName string, clusters []string,
-	) []string {
+	genUsageHeader = func() []string {
+		return []string{"RESOURCE KIND", "RESOURCE NAMESPACE", "RESOURCE NAME", "CLUSTERS"}
+	}
+
+	genUsageRow = func(u *usage) []string {
+		name := u.Name
+		if u.Paused {
+			name += pausedSuffix
+		}
 		return []string{
-			resourceKind,
-			resourceNamespace,
-			resourceName,
-			strings.Join(clusters, "\n"),
+			u.Kind,
+			u.Namespace,
+			name,
+			strings.Join(u.Clusters, "\n"),
 		}
 	}
 )
 
-func showUsage(ctx context.Context, kind, passedNamespace, passedName string, logger logr.Logger) error {
+func showUsage(ctx context.Context, kind, passedNamespace, passedName string, format outputFormat,
+	logger logr.Logger) error {
+
+	usages, err := collectUsage(ctx, kind, passedNamespace, passedName, logger)
+	if err != nil {
+		return err
+	}
+
+	if format.isStructured() {
+		return printResults(format, usageListKind, usages)
+	}
+
 	table := tablewriter.NewWriter(os.Stdout)
-	table.Header("RESOURCE KIND", "RESOURCE NAMESPACE", "RESOURCE NAME", "CLUSTERS")
+	table.Header(genUsageHeader())
 
-	if kind == "" || kind == configv1beta1.ClusterProfileKind {
-		if err := showUsageForClusterProfiles(ctx, passedName, table, logger); err != nil {
+	for i := range usages {
+		if err := table.Append(genUsageRow(&usages[i])); err != nil {
 			return err
 		}
 	}
+
+	return table.Render()
+}
+
+func collectUsage(ctx context.Context, kind, passedNamespace, passedName string, logger logr.Logger,
+) ([]usage, error) {
+
+	usages := make([]usage, 0)
+	if kind == "" || kind == configv1beta1.ClusterProfileKind {
+		result, err := showUsageForClusterProfiles(ctx, passedName, logger)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, result...)
+	}
 	if kind == "" || kind == configv1beta1.ProfileKind {
-		if err := showUsageForProfiles(ctx, passedName, table, logger); err != nil {
-			return err
+		result, err := showUsageForProfiles(ctx, passedName, logger)
+		if err != nil {
+			return nil, err
 		}
+		usages = append(usages, result...)
 	}
 	if kind == "" || kind == string(libsveltosv1beta1.ConfigMapReferencedResourceKind) {
-		if err := showUsageForConfigMaps(ctx, passedNamespace, passedName, table, logger); err != nil {
-			return err
+		result, err := showUsageForConfigMaps(ctx, passedNamespace, passedName, logger)
+		if err != nil {
+			return nil, err
 		}
+		usages = append(usages, result...)
 	}
 	if kind == "" || kind == string(libsveltosv1beta1.SecretReferencedResourceKind) {
-		if err := showUsageForSecrets(ctx, passedNamespace, passedName, table, logger); err != nil {
-			return err
+		result, err := showUsageForSecrets(ctx, passedNamespace, passedName, logger)
+		if err != nil {
+			return nil, err
 		}
+		usages = append(usages, result...)
 	}
 
-	return table.Render()
-}
-
-func getMatchingClusters(matchingClusterRefs []corev1.ObjectReference) []string {
-	clusters := make([]string, len(matchingClusterRefs))
-	for i := range matchingClusterRefs {
-		c := &matchingClusterRefs[i]
-		clusters[i] = fmt.Sprintf("%s/%s", c.Namespace, c.Name)
-	}
-	return clusters
+	return usages, nil
 }
 
-func showUsageForClusterProfiles(ctx context.Context, passedName string, table *tablewriter.Table, logger logr.Logger) error {
+func showUsageForClusterProfiles(ctx context.Context, passedName string, logger logr.Logger) ([]usage, error) {
 	instance := utils.GetAccessInstance()
 
 	cps, err := instance.ListClusterProfiles(ctx, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	usages := make([]usage, 0)
 	for i := range cps.Items {
 		cp := &cps.Items[i]
 		if passedName == "" || cp.Name == passedName {
-			if err := showUsageForClusterProfile(cp, table, logger); err != nil {
-				return err
-			}
+			usages = append(usages, showUsageForClusterProfile(cp, logger))
 		}
 	}
 
-	return nil
+	return usages, nil
 }
 
-func showUsageForClusterProfile(clusterProfile *configv1beta1.ClusterProfile, table *tablewriter.Table,
-	logger logr.Logger) error {
-
+func showUsageForClusterProfile(clusterProfile *configv1beta1.ClusterProfile, logger logr.Logger) usage {
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering ClusterProfile %s", clusterProfile.Name))
 
-	clusters := getMatchingClusters(clusterProfile.Status.MatchingClusterRefs)
-
-	return table.Append(genUsageRow(configv1beta1.ClusterProfileKind, "", clusterProfile.Name, clusters))
+	return usage{
+		Kind:     configv1beta1.ClusterProfileKind,
+		Name:     clusterProfile.Name,
+		Clusters: utils.GetMatchingClusters(clusterProfile.Status.MatchingClusterRefs),
+		Paused:   utils.IsProfilePaused(clusterProfile),
+	}
 }
 
-func showUsageForProfiles(ctx context.Context, passedName string, table *tablewriter.Table, logger logr.Logger) error {
+func showUsageForProfiles(ctx context.Context, passedName string, logger logr.Logger) ([]usage, error) {
 	instance := utils.GetAccessInstance()
 
 	ps, err := instance.ListProfiles(ctx, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	usages := make([]usage, 0)
 	for i := range ps.Items {
 		p := &ps.Items[i]
 		if passedName == "" || p.Name == passedName {
-			if err := showUsageForProfile(p, table, logger); err != nil {
-				return err
-			}
+			usages = append(usages, showUsageForProfile(p, logger))
 		}
 	}
 
-	return nil
+	return usages, nil
 }
 
-func showUsageForProfile(profile *configv1beta1.Profile, table *tablewriter.Table,
-	logger logr.Logger) error {
-
+func showUsageForProfile(profile *configv1beta1.Profile, logger logr.Logger) usage {
 	logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering Profile %s", profile.Name))
 
-	clusters := getMatchingClusters(profile.Status.MatchingClusterRefs)
-
-	return table.Append(genUsageRow(configv1beta1.ProfileKind, "", profile.Name, clusters))
+	return usage{
+		Kind:     configv1beta1.ProfileKind,
+		Name:     profile.Name,
+		Clusters: utils.GetMatchingClusters(profile.Status.MatchingClusterRefs),
+		Paused:   utils.IsProfilePaused(profile),
+	}
 }
 
 func showUsageForReferencedResources(ctx context.Context, passedNamespace, passedName string,
-	kind libsveltosv1beta1.ReferencedResourceKind, table *tablewriter.Table, logger logr.Logger) error {
+	kind libsveltosv1beta1.ReferencedResourceKind, logger logr.Logger) ([]usage, error) {
 
 	instance := utils.GetAccessInstance()
 	result := make(map[configv1beta1.PolicyRef][]string)
 
 	cps, err := instance.ListClusterProfiles(ctx, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for i := range cps.Items {
@@ -167,7 +213,7 @@ func showUsageForReferencedResources(ctx context.Context, passedNamespace, passe
 
 	ps, err := instance.ListProfiles(ctx, logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for i := range ps.Items {
@@ -178,28 +224,31 @@ func showUsageForReferencedResources(ctx context.Context, passedNamespace, passe
 			result, logger)
 	}
 
+	usages := make([]usage, 0, len(result))
 	for pr := range result {
-		if err := table.Append(genUsageRow(string(kind),
-			pr.Namespace, pr.Name, result[pr])); err != nil {
-			return err
-		}
+		usages = append(usages, usage{
+			Kind:      string(kind),
+			Namespace: pr.Namespace,
+			Name:      pr.Name,
+			Clusters:  result[pr],
+		})
 	}
 
-	return nil
+	return usages, nil
 }
 
 func showUsageForConfigMaps(ctx context.Context, passedNamespace, passedName string,
-	table *tablewriter.Table, logger logr.Logger) error {
+	logger logr.Logger) ([]usage, error) {
 
 	return showUsageForReferencedResources(ctx, passedNamespace, passedName, libsveltosv1beta1.ConfigMapReferencedResourceKind,
-		table, logger)
+		logger)
 }
 
 func showUsageForSecrets(ctx context.Context, passedNamespace, passedName string,
-	table *tablewriter.Table, logger logr.Logger) error {
+	logger logr.Logger) ([]usage, error) {
 
 	return showUsageForReferencedResources(ctx, passedNamespace, passedName, libsveltosv1beta1.SecretReferencedResourceKind,
-		table, logger)
+		logger)
 }
 
 func getReferencedResources(passedNamespace, passedName string, kind libsveltosv1beta1.ReferencedResourceKind,
@@ -229,7 +278,7 @@ func getConfigMaps(passedNamespace, passedName string, policyRefs []configv1beta
 		}
 	}
 
-	clusters := getMatchingClusters(matchingClusterRefs)
+	clusters := utils.GetMatchingClusters(matchingClusterRefs)
 
 	for i := range configMaps {
 		cm := &configMaps[i]
@@ -255,7 +304,7 @@ func getSecrets(passedNamespace, passedName string, policyRefs []configv1beta1.P
 		}
 	}
 
-	clusters := getMatchingClusters(matchingClusterRefs)
+	clusters := utils.GetMatchingClusters(matchingClusterRefs)
 
 	for i := range secrets {
 		secret := &secrets[i]
@@ -285,7 +334,8 @@ func shouldAddPolicyRef(passedNamespace, passedName string, pr *configv1beta1.Po
 // Usage displays CAPI cluster where policies (ClusterProfiles and referenced ConfigMaps/Secrets) are deployed
 func Usage(ctx context.Context, args []string, logger logr.Logger) error {
 	doc := `Usage:
-  sveltosctl show usage [options] [--kind=<name>] [--namespace=<resourceNamespace>] [--name=<resourceName>] [--verbose]
+  sveltosctl show usage [options] [--kind=<name>] [--namespace=<resourceNamespace>] [--name=<resourceName>]
+                        [--output=<format>] [--verbose]
 
      --kind=<name>                    Show usage information for resources of this Kind only.
                                       If not specified, ClusterProfile/Profile and referenced ConfigMap and Secret are considered.
@@ -296,11 +346,13 @@ func Usage(ctx context.Context, args []string, logger logr.Logger) error {
 
 Options:
   -h --help                  Show this screen.
+  -o --output=<format>       Output format: table, wide, json or yaml [default: table].
      --verbose               Verbose mode. Print each step.
 
 Description:
   The show usage command display usage information:
-  - for each ClusterProfile lists all CAPI clusters currently matching;
+  - for each ClusterProfile lists all CAPI clusters currently matching. Paused ClusterProfiles/Profiles
+    are marked "(paused)";
   - for each ConfigMap/Secret referenced by at least one ClusterProfile, lists all CAPI clusters where content of such resource is currently deployed.
 `
 	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
@@ -351,5 +403,10 @@ Description:
 		}
 	}
 
-	return showUsage(ctx, kind, namespace, name, logger)
+	format, err := getOutputFormat(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	return showUsage(ctx, kind, namespace, name, format, logger)
 }
@@ -79,7 +79,7 @@ var _ = Describe("Usage", func() {
 		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
 
 		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
-		err = show.ShowUsage(context.TODO(), "", "", "",
+		err = show.ShowUsage(context.TODO(), "", "", "", show.OutputFormatTable,
 			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
 		Expect(err).To(BeNil())
 
@@ -109,6 +109,32 @@ var _ = Describe("Usage", func() {
 			secret.Namespace, secret.Name, &clusterProfile2.Status.MatchingClusterRefs[0])
 		os.Stdout = old
 	})
+
+	It("showUsage marks paused ClusterProfiles", func() {
+		clusterProfile := generateClusterProfile()
+		clusterProfile.Annotations = map[string]string{configv1beta1.ProfilePausedAnnotation: "true"}
+
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(clusterProfile).Build()
+
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+		err = show.ShowUsage(context.TODO(), configv1beta1.ClusterProfileKind, "", "", show.OutputFormatTable,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+
+		w.Close()
+		var buf bytes.Buffer
+		_, err = io.Copy(&buf, r)
+		Expect(err).To(BeNil())
+		os.Stdout = old
+
+		Expect(buf.String()).To(ContainSubstring(clusterProfile.Name + " (paused)"))
+	})
 })
 
 func verifyClusterProfileUsage(lines []string, clusterProfile *configv1beta1.ClusterProfile) {
@@ -17,9 +17,19 @@ limitations under the License.
 package show
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 
 	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	// pausedSuffix marks paused ClusterProfiles/Profiles in tables
+	pausedSuffix = " (paused)"
 )
 
 func doConsiderNamespace(ns *corev1.Namespace, passedNamespace string) bool {
@@ -68,3 +78,41 @@ func doConsiderProfile(profileNames []string, passedProfile string) bool {
 
 	return false
 }
+
+func doConsiderCluster(cluster *corev1.ObjectReference, passedNamespace, passedCluster string) bool {
+	if passedNamespace != "" && cluster.Namespace != passedNamespace {
+		return false
+	}
+
+	return passedCluster == "" || cluster.Name == passedCluster
+}
+
+// getPausedProfiles returns the paused ClusterProfiles (as ClusterProfile/name) and
+// Profiles (as Profile/namespace/name)
+func getPausedProfiles(ctx context.Context, logger logr.Logger) (map[string]bool, error) {
+	instance := utils.GetAccessInstance()
+
+	result := make(map[string]bool)
+
+	cps, err := instance.ListClusterProfiles(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	for i := range cps.Items {
+		if utils.IsProfilePaused(&cps.Items[i]) {
+			result[fmt.Sprintf("%s/%s", configv1beta1.ClusterProfileKind, cps.Items[i].Name)] = true
+		}
+	}
+
+	ps, err := instance.ListProfiles(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ps.Items {
+		if utils.IsProfilePaused(&ps.Items[i]) {
+			result[fmt.Sprintf("%s/%s/%s", configv1beta1.ProfileKind, ps.Items[i].Namespace, ps.Items[i].Name)] = true
+		}
+	}
+
+	return result, nil
+}
@@ -18,9 +18,11 @@ package show_test
 
 import (
 	"fmt"
+	"strings"
 	"time"
 	"unicode/utf8"
 
+	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
@@ -218,3 +220,25 @@ func addTypeInformationToObject(obj client.Object) error {
 
 	return nil
 }
+
+// verifyTableRow verifies one of the lines contains all values
+func verifyTableRow(lines []string, values ...string) {
+	found := false
+	for i := range lines {
+		found = true
+		for j := range values {
+			if !strings.Contains(lines[i], values[j]) {
+				found = false
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+	if found != true {
+		By(fmt.Sprintf("Failed to verify row %v", values))
+		By(fmt.Sprintf("Results: %v", lines))
+	}
+	Expect(found).To(BeTrue())
+}
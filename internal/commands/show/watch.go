@@ -0,0 +1,203 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	"golang.org/x/term"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	rowAdded    = "ADDED"
+	rowModified = "MODIFIED"
+	rowDeleted  = "DELETED"
+
+	// clearScreen moves the cursor to the top left corner and clears the terminal
+	clearScreen = "\033[H\033[2J"
+)
+
+var (
+	errWatchStructuredOutput = errors.New("--watch is only supported with table and wide output")
+)
+
+// watchedRow is a table row kept up to date by --watch.
+type watchedRow struct {
+	// key uniquely identifies the row across collections
+	key   string
+	cells []string
+}
+
+// rowChange is a row added, modified or deleted between two collections
+type rowChange struct {
+	action string
+	cells  []string
+}
+
+// watchedTable describes a show command table kept up to date by --watch
+type watchedTable struct {
+	header []string
+	// lists are the resources watched. Any event on those causes rows to be collected again
+	lists       []client.ObjectList
+	listOptions []client.ListOption
+	// collect returns the current rows
+	collect func(ctx context.Context) ([]watchedRow, error)
+}
+
+// watchTable renders the table and then keeps it up to date till context is cancelled.
+// When stdout is a terminal the whole table is redrawn on every change. Otherwise only
+// changed rows are streamed, each prefixed by a timestamp and the change type.
+func watchTable(ctx context.Context, wt *watchedTable, logger logr.Logger) error {
+	tty := term.IsTerminal(int(os.Stdout.Fd()))
+	return runWatchTable(ctx, os.Stdout, tty, wt, logger)
+}
+
+func runWatchTable(ctx context.Context, out io.Writer, tty bool, wt *watchedTable, logger logr.Logger) error {
+	events := make(chan struct{}, 1)
+	watchErrors := make(chan error, len(wt.lists))
+	for i := range wt.lists {
+		go func(list client.ObjectList) {
+			watchErrors <- utils.GetAccessInstance().NotifyChanges(ctx, list, events, logger, wt.listOptions...)
+		}(wt.lists[i])
+	}
+
+	rows, err := collectWatchedRows(ctx, wt)
+	if err != nil {
+		return err
+	}
+	if tty {
+		_, _ = fmt.Fprint(out, clearScreen)
+	}
+	if err := renderWatchedRows(out, wt.header, rows); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watchErrors:
+			if err != nil {
+				return err
+			}
+			continue
+		case <-events:
+		}
+
+		current, err := collectWatchedRows(ctx, wt)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		changes := diffRows(rows, current)
+		rows = current
+		if len(changes) == 0 {
+			continue
+		}
+
+		now := time.Now().Format(time.RFC3339)
+		if tty {
+			_, _ = fmt.Fprint(out, clearScreen)
+			if err := renderWatchedRows(out, wt.header, rows); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(out, "Last change: %s (%d rows changed)\n", now, len(changes))
+			continue
+		}
+
+		for i := range changes {
+			_, _ = fmt.Fprintf(out, "%s %-8s %s\n", now, changes[i].action,
+				strings.Join(changes[i].cells, " | "))
+		}
+	}
+}
+
+// collectWatchedRows collects rows sorted by key so redrawn tables are stable
+func collectWatchedRows(ctx context.Context, wt *watchedTable) ([]watchedRow, error) {
+	rows, err := wt.collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(rows, func(a, b watchedRow) int {
+		return strings.Compare(a.key, b.key)
+	})
+	return rows, nil
+}
+
+// namespaceListOptions returns the list options restricting watched resources to namespace.
+// No restriction is applied when namespace is empty.
+func namespaceListOptions(namespace string) []client.ListOption {
+	if namespace == "" {
+		return nil
+	}
+	return []client.ListOption{client.InNamespace(namespace)}
+}
+
+func renderWatchedRows(out io.Writer, header []string, rows []watchedRow) error {
+	table := tablewriter.NewWriter(out)
+	table.Header(header)
+	for i := range rows {
+		if err := table.Append(rows[i].cells); err != nil {
+			return err
+		}
+	}
+	return table.Render()
+}
+
+// diffRows returns the rows added, modified or deleted going from previous to current
+func diffRows(previous, current []watchedRow) []rowChange {
+	previousRows := make(map[string][]string, len(previous))
+	for i := range previous {
+		previousRows[previous[i].key] = previous[i].cells
+	}
+
+	changes := make([]rowChange, 0)
+	currentKeys := make(map[string]bool, len(current))
+	for i := range current {
+		currentKeys[current[i].key] = true
+		cells, ok := previousRows[current[i].key]
+		switch {
+		case !ok:
+			changes = append(changes, rowChange{action: rowAdded, cells: current[i].cells})
+		case !slices.Equal(cells, current[i].cells):
+			changes = append(changes, rowChange{action: rowModified, cells: current[i].cells})
+		}
+	}
+
+	for i := range previous {
+		if !currentKeys[previous[i].key] {
+			changes = append(changes, rowChange{action: rowDeleted, cells: previous[i].cells})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package show_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/show"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Watch", func() {
+	It("show addons --watch streams changed rows when stdout is not a terminal", func() {
+		namespace := namePrefix + randomString()
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+
+		clusterProfileName := randomString()
+		initialResource := generateResource()
+		clusterConfiguration := &configv1beta1.ClusterConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      randomString(),
+			},
+		}
+		clusterConfiguration = addDeployedResources(clusterConfiguration, clusterProfileName,
+			[]configv1beta1.DeployedResource{*initialResource})
+
+		scheme, err := utils.GetScheme()
+		Expect(err).To(BeNil())
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects([]client.Object{ns, clusterConfiguration}...).Build()
+		utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+
+		old := os.Stdout // keep backup of the real stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		buf := gbytes.NewBuffer()
+		go func() {
+			defer GinkgoRecover()
+			_, _ = io.Copy(buf, r)
+		}()
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		done := make(chan error)
+		go func() {
+			done <- show.WatchAddOns(ctx, "", "", "", show.OutputFormatTable,
+				textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		}()
+
+		// Initial table
+		Eventually(buf, time.Minute).Should(gbytes.Say(initialResource.Name))
+
+		currentClusterConfiguration := &configv1beta1.ClusterConfiguration{}
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(clusterConfiguration),
+			currentClusterConfiguration)).To(Succeed())
+		newResource := generateResource()
+		currentClusterConfiguration = addDeployedResources(currentClusterConfiguration, clusterProfileName,
+			[]configv1beta1.DeployedResource{*newResource})
+		Expect(c.Update(context.TODO(), currentClusterConfiguration)).To(Succeed())
+
+		Eventually(buf, time.Minute).Should(gbytes.Say("ADDED .*" + newResource.Name))
+
+		Expect(c.Delete(context.TODO(), currentClusterConfiguration)).To(Succeed())
+		Eventually(buf, time.Minute).Should(gbytes.Say("DELETED"))
+
+		cancel()
+		Eventually(done, time.Minute).Should(Receive(BeNil()))
+
+		w.Close()
+		os.Stdout = old
+	})
+
+	It("show addons --watch does not support structured output", func() {
+		err := show.WatchAddOns(context.TODO(), "", "", "", show.OutputFormatJSON,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,220 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+)
+
+const (
+	actionAdded    = "Added"
+	actionDeleted  = "Deleted"
+	actionModified = "Modified"
+)
+
+// objectChange is a difference, for a single object, between two samples
+type objectChange struct {
+	kind      string
+	namespace string
+	name      string
+	action    string
+	// diff is the unified diff. Set for modified objects only.
+	diff string
+}
+
+var (
+	genDiffHeader = func() []string {
+		return []string{"RESOURCE KIND", "RESOURCE NAMESPACE", "RESOURCE NAME", "ACTION"}
+	}
+)
+
+// diffSamples returns, sorted by kind, namespace and name, all differences between two samples
+func diffSamples(from, to map[string]*unstructured.Unstructured) ([]objectChange, error) {
+	changes := make([]objectChange, 0)
+
+	for key, toObject := range to {
+		fromObject, ok := from[key]
+		if !ok {
+			changes = append(changes, newObjectChange(toObject, actionAdded))
+			continue
+		}
+
+		fromData, err := yaml.Marshal(fromObject.Object)
+		if err != nil {
+			return nil, err
+		}
+		toData, err := yaml.Marshal(toObject.Object)
+		if err != nil {
+			return nil, err
+		}
+		if string(fromData) == string(toData) {
+			continue
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(fromData)),
+			B:        difflib.SplitLines(string(toData)),
+			FromFile: "from " + key,
+			ToFile:   "to " + key,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, err
+		}
+		change := newObjectChange(toObject, actionModified)
+		change.diff = diff
+		changes = append(changes, change)
+	}
+
+	for key, fromObject := range from {
+		if _, ok := to[key]; !ok {
+			changes = append(changes, newObjectChange(fromObject, actionDeleted))
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].kind != changes[j].kind {
+			return changes[i].kind < changes[j].kind
+		}
+		if changes[i].namespace != changes[j].namespace {
+			return changes[i].namespace < changes[j].namespace
+		}
+		return changes[i].name < changes[j].name
+	})
+
+	return changes, nil
+}
+
+func newObjectChange(u *unstructured.Unstructured, action string) objectChange {
+	return objectChange{
+		kind:      u.GetKind(),
+		namespace: u.GetNamespace(),
+		name:      u.GetName(),
+		action:    action,
+	}
+}
+
+func displayDiff(ctx context.Context, snapshotName, fromSample, toSample string, filter *sampleObjectFilter,
+	rawDiff bool, logger logr.Logger) error {
+
+	from, err := loadSample(ctx, snapshotName, fromSample, filter, logger)
+	if err != nil {
+		return err
+	}
+	to, err := loadSample(ctx, snapshotName, toSample, filter, logger)
+	if err != nil {
+		return err
+	}
+
+	changes, err := diffSamples(from, to)
+	if err != nil {
+		return err
+	}
+
+	if rawDiff {
+		for i := range changes {
+			if changes[i].diff != "" {
+				//nolint: forbidigo // print diff
+				fmt.Println(changes[i].diff)
+			}
+		}
+		return nil
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genDiffHeader())
+	for i := range changes {
+		row := []string{changes[i].kind, changes[i].namespace, changes[i].name, changes[i].action}
+		if err := table.Append(row); err != nil {
+			return err
+		}
+	}
+
+	return table.Render()
+}
+
+// Diff displays the differences between two samples collected for a Snapshot
+func Diff(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl snapshot diff [options] --snapshot=<name> --from-sample=<name> --to-sample=<name>
+                           [--kind=<kind>] [--namespace=<name>] [--raw-diff] [--verbose]
+
+     --snapshot=<name>      Name of the Snapshot instance.
+     --from-sample=<name>   Name of the sample to compare from. Use 'sveltosctl snapshot list' to see samples.
+     --to-sample=<name>     Name of the sample to compare to.
+     --kind=<kind>          Consider only resources of this kind, for instance ClusterProfile.
+                            If not specified all kinds are considered.
+     --namespace=<name>     Consider only resources in this namespace.
+                            If not specified all namespaces are considered.
+     --raw-diff             With this flag, for each resource modified between the two samples, full diff
+                            will be displayed.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The snapshot diff command shows which resources were added, deleted or modified between two
+  samples collected for a Snapshot.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	filter := &sampleObjectFilter{}
+	if passedKind := parsedArgs["--kind"]; passedKind != nil {
+		filter.kind = passedKind.(string)
+	}
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		filter.namespace = passedNamespace.(string)
+	}
+
+	return displayDiff(ctx, parsedArgs["--snapshot"].(string), parsedArgs["--from-sample"].(string),
+		parsedArgs["--to-sample"].(string), filter, parsedArgs["--raw-diff"].(bool), logger)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/snapshotter"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var (
+	genListHeader = func() []string {
+		return []string{"SNAPSHOT POLICY", "DATE"}
+	}
+)
+
+func listSnapshots(ctx context.Context, passedSnapshot string, logger logr.Logger) error {
+	instance := utils.GetAccessInstance()
+
+	snapshots, err := instance.ListSnapshots(ctx, logger)
+	if err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header(genListHeader())
+
+	for i := range snapshots.Items {
+		snapshot := &snapshots.Items[i]
+		if passedSnapshot != "" && snapshot.Name != passedSnapshot {
+			continue
+		}
+
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering Snapshot %s", snapshot.Name))
+		samples, err := snapshotter.ListSamples(snapshot)
+		if err != nil {
+			return err
+		}
+		for j := range samples {
+			if err := table.Append([]string{snapshot.Name, samples[j]}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return table.Render()
+}
+
+// List displays all samples collected for each Snapshot
+func List(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl snapshot list [options] [--snapshot=<name>] [--verbose]
+
+     --snapshot=<name>      Show samples collected for this Snapshot instance.
+                            If not specified all Snapshot instances are considered.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The snapshot list command shows, for each Snapshot instance, all the samples collected so far.
+  Samples are read from the Snapshot storage, so this command needs to run where the
+  sveltosctl agent runs (for instance with kubectl exec in the sveltosctl pod).
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	snapshot := ""
+	if passedSnapshot := parsedArgs["--snapshot"]; passedSnapshot != nil {
+		snapshot = passedSnapshot.(string)
+	}
+
+	return listSnapshots(ctx, snapshot, logger)
+}
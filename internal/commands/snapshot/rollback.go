@@ -0,0 +1,160 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+// rollbackToSample restores all objects, matching filter, stored in a sample.
+// Objects created after the sample was collected are left untouched.
+func rollbackToSample(ctx context.Context, snapshotName, sample string, filter *sampleObjectFilter,
+	logger logr.Logger) error {
+
+	objects, err := loadSample(ctx, snapshotName, sample, filter, logger)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	// ConfigMaps and Secrets are restored first so that ClusterProfiles/Profiles/EventTriggers/RoleRequests
+	// find the content they reference once restored.
+	sort.Slice(keys, func(i, j int) bool {
+		iReferenced := isReferencedKind(objects[keys[i]].GetKind())
+		jReferenced := isReferencedKind(objects[keys[j]].GetKind())
+		if iReferenced != jReferenced {
+			return iReferenced
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, key := range keys {
+		if err := restoreObject(ctx, objects[key], logger); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", key, err)
+		}
+	}
+
+	logger.V(logs.LogInfo).Info(fmt.Sprintf("%d resources restored from sample %s", len(keys), sample))
+	return nil
+}
+
+func isReferencedKind(kind string) bool {
+	return kind == string(libsveltosv1beta1.ConfigMapReferencedResourceKind) ||
+		kind == string(libsveltosv1beta1.SecretReferencedResourceKind)
+}
+
+// restoreObject creates the object if it does not exist anymore, updates it otherwise.
+// A re-created object has no owner: owners stored in the sample might not exist anymore and
+// the garbage collector would then delete the restored object right away.
+func restoreObject(ctx context.Context, u *unstructured.Unstructured, logger logr.Logger) error {
+	instance := utils.GetAccessInstance()
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(u.GroupVersionKind())
+	err := instance.GetResource(ctx, client.ObjectKeyFromObject(u), current)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(logs.LogDebug).Info(fmt.Sprintf("creating %s %s/%s", u.GetKind(), u.GetNamespace(), u.GetName()))
+			u.SetOwnerReferences(nil)
+			return instance.CreateResource(ctx, u)
+		}
+		return err
+	}
+
+	logger.V(logs.LogDebug).Info(fmt.Sprintf("updating %s %s/%s", u.GetKind(), u.GetNamespace(), u.GetName()))
+	u.SetResourceVersion(current.GetResourceVersion())
+	return instance.UpdateResource(ctx, u)
+}
+
+// Rollback restores the configuration stored in a sample collected for a Snapshot
+func Rollback(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl snapshot rollback [options] --snapshot=<name> --sample=<name>
+                               [--kind=<kind>] [--namespace=<name>] [--name=<name>] [--verbose]
+
+     --snapshot=<name>      Name of the Snapshot instance.
+     --sample=<name>        Name of the sample to rollback to. Use 'sveltosctl snapshot list' to see samples.
+     --kind=<kind>          Rollback only resources of this kind, for instance ClusterProfile.
+                            If not specified all kinds are considered.
+     --namespace=<name>     Rollback only resources in this namespace.
+                            If not specified all namespaces are considered.
+     --name=<name>          Rollback only resources with this name.
+                            If not specified all names are considered.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The snapshot rollback command restores ClusterProfiles, Profiles, referenced ConfigMaps/Secrets,
+  Classifiers, RoleRequests and EventTriggers to the state stored in a sample.
+  Resources which no longer exist are recreated without their owner references. Resources
+  created after the sample was collected are not removed.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	filter := &sampleObjectFilter{}
+	if passedKind := parsedArgs["--kind"]; passedKind != nil {
+		filter.kind = passedKind.(string)
+	}
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		filter.namespace = passedNamespace.(string)
+	}
+	if passedName := parsedArgs["--name"]; passedName != nil {
+		filter.name = passedName.(string)
+	}
+
+	return rollbackToSample(ctx, parsedArgs["--snapshot"].(string), parsedArgs["--sample"].(string),
+		filter, logger)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/collector"
+	"github.com/projectsveltos/sveltosctl/internal/snapshotter"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+// sampleObjectFilter selects the objects of a sample to consider. Empty fields match any value.
+type sampleObjectFilter struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (f *sampleObjectFilter) matches(u *unstructured.Unstructured) bool {
+	if f.kind != "" && f.kind != u.GetKind() {
+		return false
+	}
+	if f.namespace != "" && f.namespace != u.GetNamespace() {
+		return false
+	}
+	if f.name != "" && f.name != u.GetName() {
+		return false
+	}
+	return true
+}
+
+// getObjectKey returns the key identifying an object across samples => Kind/namespace/name
+func getObjectKey(u *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", u.GetKind(), u.GetNamespace(), u.GetName())
+}
+
+// loadSample returns all objects, matching filter, stored in a sample of a Snapshot.
+// Returned objects are cleaned of all fields set by the apiserver.
+func loadSample(ctx context.Context, snapshotName, sample string, filter *sampleObjectFilter,
+	logger logr.Logger) (map[string]*unstructured.Unstructured, error) {
+
+	snapshot, err := utils.GetAccessInstance().GetSnapshot(ctx, snapshotName, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := snapshotter.GetSampleFolder(snapshot, sample)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.V(logs.LogDebug).Info(fmt.Sprintf("loading sample %s from %s", sample, folder))
+	objects, err := collector.LoadObjects(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*unstructured.Unstructured)
+	for i := range objects {
+		if filter.matches(objects[i]) {
+			cleanObject(objects[i])
+			result[getObjectKey(objects[i])] = objects[i]
+		}
+	}
+	return result, nil
+}
+
+// cleanObject removes status and all metadata fields set by the apiserver, so that objects
+// can be compared across samples and restored.
+func cleanObject(u *unstructured.Unstructured) {
+	for _, field := range []string{"resourceVersion", "uid", "generation", "creationTimestamp", "managedFields",
+		"deletionTimestamp", "deletionGracePeriodSeconds"} {
+		unstructured.RemoveNestedField(u.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(u.Object, "status")
+}
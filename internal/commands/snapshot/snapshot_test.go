@@ -0,0 +1,191 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	utilsv1beta1 "github.com/projectsveltos/sveltosctl/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/collector"
+	"github.com/projectsveltos/sveltosctl/internal/commands/snapshot"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	fromSample = "2026-01-10:10:00:00"
+	toSample   = "2026-01-10:11:00:00"
+)
+
+var _ = Describe("Snapshot", func() {
+	var snapshotInstance *utilsv1beta1.Snapshot
+	var modified *corev1.ConfigMap
+	var deleted *configv1beta1.ClusterProfile
+	var added *configv1beta1.ClusterProfile
+	var c client.Client
+
+	BeforeEach(func() {
+		snapshotInstance = &utilsv1beta1.Snapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Spec: utilsv1beta1.SnapshotSpec{
+				Schedule: "0 * * * *",
+				Storage:  GinkgoT().TempDir(),
+			},
+		}
+
+		modified = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+			},
+			Data: map[string]string{"replicas": "1"},
+		}
+		deleted = &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: randomString()},
+		}
+		added = &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: randomString()},
+		}
+
+		c = initializeAccess(snapshotInstance)
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+
+		fromFolder := filepath.Join(snapshotInstance.Spec.Storage, snapshotInstance.Name, fromSample)
+		Expect(collector.DumpObject(modified, fromFolder, logger)).To(Succeed())
+		Expect(collector.DumpObject(deleted, fromFolder, logger)).To(Succeed())
+
+		toFolder := filepath.Join(snapshotInstance.Spec.Storage, snapshotInstance.Name, toSample)
+		current := modified.DeepCopy()
+		current.Data["replicas"] = "3"
+		Expect(collector.DumpObject(current, toFolder, logger)).To(Succeed())
+		Expect(collector.DumpObject(added, toFolder, logger)).To(Succeed())
+
+		// Current state of the cluster is the latest sample
+		Expect(c.Create(context.TODO(), current)).To(Succeed())
+		Expect(c.Create(context.TODO(), added)).To(Succeed())
+	})
+
+	It("listSnapshots displays collected samples", func() {
+		output := captureStdout(func() error {
+			return snapshot.ListSnapshots(context.TODO(), "", textlogger.NewLogger(textlogger.NewConfig()))
+		})
+
+		Expect(output).To(MatchRegexp(snapshotInstance.Name + `\s+│\s+` + fromSample))
+		Expect(output).To(MatchRegexp(snapshotInstance.Name + `\s+│\s+` + toSample))
+	})
+
+	It("displayDiff displays added, deleted and modified resources", func() {
+		output := captureStdout(func() error {
+			return snapshot.DisplayDiff(context.TODO(), snapshotInstance.Name, fromSample, toSample,
+				snapshot.NewSampleObjectFilter("", "", ""), false, textlogger.NewLogger(textlogger.NewConfig()))
+		})
+
+		Expect(output).To(MatchRegexp(modified.Name + `\s+│\s+Modified`))
+		Expect(output).To(MatchRegexp(deleted.Name + `\s+│\s+Deleted`))
+		Expect(output).To(MatchRegexp(added.Name + `\s+│\s+Added`))
+
+		output = captureStdout(func() error {
+			return snapshot.DisplayDiff(context.TODO(), snapshotInstance.Name, fromSample, toSample,
+				snapshot.NewSampleObjectFilter("ConfigMap", "", ""), true, textlogger.NewLogger(textlogger.NewConfig()))
+		})
+		Expect(output).To(ContainSubstring(`-  replicas: "1"`))
+		Expect(output).To(ContainSubstring(`+  replicas: "3"`))
+		Expect(output).ToNot(ContainSubstring(deleted.Name))
+	})
+
+	It("rollbackToSample restores modified and deleted resources", func() {
+		Expect(snapshot.RollbackToSample(context.TODO(), snapshotInstance.Name, fromSample,
+			snapshot.NewSampleObjectFilter("", "", ""), textlogger.NewLogger(textlogger.NewConfig()))).To(Succeed())
+
+		currentConfigMap := &corev1.ConfigMap{}
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(modified), currentConfigMap)).To(Succeed())
+		Expect(currentConfigMap.Data["replicas"]).To(Equal("1"))
+
+		currentClusterProfile := &configv1beta1.ClusterProfile{}
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(deleted), currentClusterProfile)).To(Succeed())
+
+		// Resources created after the sample are left untouched
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(added), currentClusterProfile)).To(Succeed())
+	})
+
+	It("rollbackToSample restores deleted resources without their owners", func() {
+		owned := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: randomString(),
+				Name:      randomString(),
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "ConfigMap", Name: randomString(), UID: "123"},
+				},
+			},
+			Data: map[string]string{"replicas": "1"},
+		}
+		fromFolder := filepath.Join(snapshotInstance.Spec.Storage, snapshotInstance.Name, fromSample)
+		Expect(collector.DumpObject(owned, fromFolder, textlogger.NewLogger(textlogger.NewConfig()))).To(Succeed())
+
+		Expect(snapshot.RollbackToSample(context.TODO(), snapshotInstance.Name, fromSample,
+			snapshot.NewSampleObjectFilter("ConfigMap", owned.Namespace, owned.Name),
+			textlogger.NewLogger(textlogger.NewConfig()))).To(Succeed())
+
+		currentConfigMap := &corev1.ConfigMap{}
+		Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(owned), currentConfigMap)).To(Succeed())
+		Expect(currentConfigMap.Data["replicas"]).To(Equal("1"))
+		Expect(currentConfigMap.OwnerReferences).To(BeEmpty())
+	})
+
+	It("rollbackToSample fails when sample does not exist", func() {
+		Expect(snapshot.RollbackToSample(context.TODO(), snapshotInstance.Name, randomString(),
+			snapshot.NewSampleObjectFilter("", "", ""), textlogger.NewLogger(textlogger.NewConfig()))).ToNot(Succeed())
+	})
+})
+
+func initializeAccess(objects ...client.Object) client.Client {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	return c
+}
+
+func captureStdout(f func() error) string {
+	old := os.Stdout // keep backup of the real stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	defer func() { os.Stdout = old }()
+
+	Expect(f()).To(Succeed())
+
+	w.Close()
+	var buf bytes.Buffer
+	_, err := io.Copy(&buf, r)
+	Expect(err).To(BeNil())
+	return buf.String()
+}
@@ -0,0 +1,550 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package techsupport
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/collector"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	// sveltosGroupSuffix identifies the API groups owned by Sveltos
+	sveltosGroupSuffix = "projectsveltos.io"
+
+	// permission used for directories and files created in the bundle
+	dirPermission  = 0o755
+	filePermission = 0o600
+
+	manifestFileName  = "manifest.yaml"
+	managementFolder  = "management"
+	managedFolder     = "managed"
+	resourcesFolder   = "resources"
+	logsFolder        = "logs"
+	logFileExtension  = ".log"
+	managementCluster = "management"
+	bundleTimeFormat  = "20060102-150405"
+
+	defaultNamespace = "projectsveltos"
+	defaultLogsSince = 24 * time.Hour
+)
+
+// collectOptions contains what needs to be collected in each cluster
+type collectOptions struct {
+	// namespace where Sveltos controllers run
+	namespace string
+	// logsSince limits collected logs to the ones more recent than this duration
+	logsSince time.Duration
+	// redaction defines which Secret data is redacted
+	redaction *redactionPolicy
+	// redactionDescription is the redaction policy as passed by the user
+	redactionDescription string
+	// clusters, in the form namespace/name, to collect data from. Empty means all managed clusters.
+	clusters map[string]bool
+	// managedResources are the kinds of resources collected from each managed cluster
+	managedResources []schema.GroupVersionKind
+	// skipManagedClusters, when set, causes only the management cluster to be considered
+	skipManagedClusters bool
+}
+
+// clusterAccess contains the clients used to collect data from a cluster
+type clusterAccess struct {
+	c client.Client
+	// clientset is used to fetch pod logs. If nil, logs are not collected.
+	clientset kubernetes.Interface
+}
+
+// clusterEntry summarizes, in the bundle manifest, what was collected from a cluster
+type clusterEntry struct {
+	Cluster   string   `json:"cluster"`
+	Folder    string   `json:"folder"`
+	Resources int      `json:"resources"`
+	Logs      int      `json:"logs"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// bundleManifest describes the content of a techsupport bundle
+type bundleManifest struct {
+	CollectionTime string         `json:"collectionTime"`
+	Namespace      string         `json:"namespace"`
+	LogsSince      string         `json:"logsSince"`
+	Redaction      string         `json:"redaction"`
+	Clusters       []clusterEntry `json:"clusters"`
+}
+
+func (e *clusterEntry) addError(err error) {
+	e.Errors = append(e.Errors, err.Error())
+}
+
+// collect gathers data from the management cluster and the managed clusters and stores
+// everything, along with a manifest, in the tar.gz file output
+func collect(ctx context.Context, options *collectOptions, output string, logger logr.Logger) error {
+	now := time.Now()
+
+	tmpDir, err := os.MkdirTemp("", "sveltos-techsupport-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bundleName := "sveltos-techsupport-" + now.Format(bundleTimeFormat)
+	bundleFolder := filepath.Join(tmpDir, bundleName)
+
+	manifest := &bundleManifest{
+		CollectionTime: now.UTC().Format(time.RFC3339),
+		Namespace:      options.namespace,
+		LogsSince:      options.logsSince.String(),
+		Redaction:      options.redactionDescription,
+		Clusters:       make([]clusterEntry, 0),
+	}
+
+	instance := utils.GetAccessInstance()
+
+	access := &clusterAccess{c: instance.GetClient()}
+	if instance.GetConfig() != nil {
+		access.clientset, err = kubernetes.NewForConfig(instance.GetConfig())
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.V(logs.LogInfo).Info("collecting data from management cluster")
+	entry := collectCluster(ctx, access, bundleFolder, managementFolder, options, nil, logger)
+	entry.Cluster = managementCluster
+	manifest.Clusters = append(manifest.Clusters, *entry)
+
+	if !options.skipManagedClusters {
+		entries, err := collectManagedClusters(ctx, bundleFolder, options, logger)
+		if err != nil {
+			return err
+		}
+		manifest.Clusters = append(manifest.Clusters, entries...)
+	}
+
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(bundleFolder, manifestFileName), data, filePermission); err != nil {
+		return err
+	}
+
+	if err := createBundle(bundleFolder, output); err != nil {
+		return err
+	}
+
+	logger.V(logs.LogInfo).Info(fmt.Sprintf("techsupport bundle stored in %s", output))
+	return nil
+}
+
+// collectManagedClusters collects data from each managed cluster selected by options.
+// Clusters in pull mode are not reachable from the management cluster and are only
+// reported in the manifest.
+func collectManagedClusters(ctx context.Context, bundleFolder string, options *collectOptions,
+	logger logr.Logger) ([]clusterEntry, error) {
+
+	instance := utils.GetAccessInstance()
+
+	clusters, err := instance.ListManagedClusters(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]clusterEntry, 0)
+	for i := range clusters {
+		cluster := clusters[i]
+		clusterInfo := fmt.Sprintf("%s/%s", cluster.GetNamespace(), cluster.GetName())
+		if len(options.clusters) != 0 && !options.clusters[clusterInfo] {
+			continue
+		}
+
+		kind := cluster.GetObjectKind().GroupVersionKind().Kind
+		folder := filepath.Join(managedFolder, kind, cluster.GetNamespace(), cluster.GetName())
+		entry := &clusterEntry{Cluster: fmt.Sprintf("%s %s", kind, clusterInfo), Folder: folder}
+
+		logger.V(logs.LogInfo).Info(fmt.Sprintf("collecting data from %s %s", kind, clusterInfo))
+		access, err := getManagedClusterAccess(ctx, cluster, logger)
+		switch {
+		case err != nil:
+			entry.addError(fmt.Errorf("failed to access cluster: %w", err))
+		case access == nil:
+			entry.addError(errors.New("cluster is in pull mode and not reachable from the management cluster"))
+		default:
+			entry = collectCluster(ctx, access, bundleFolder, folder, options, options.managedResources, logger)
+			entry.Cluster = fmt.Sprintf("%s %s", kind, clusterInfo)
+		}
+
+		entries = append(entries, *entry)
+	}
+
+	return entries, nil
+}
+
+// getManagedClusterAccess returns the clients to access a managed cluster using the kubeconfig
+// stored in the management cluster. Nil is returned for SveltosClusters in pull mode.
+func getManagedClusterAccess(ctx context.Context, cluster client.Object, logger logr.Logger,
+) (*clusterAccess, error) {
+
+	instance := utils.GetAccessInstance()
+
+	ref := &corev1.ObjectReference{
+		Namespace:  cluster.GetNamespace(),
+		Name:       cluster.GetName(),
+		Kind:       cluster.GetObjectKind().GroupVersionKind().Kind,
+		APIVersion: cluster.GetObjectKind().GroupVersionKind().GroupVersion().String(),
+	}
+
+	restConfig, err := clusterproxy.GetKubernetesRestConfig(ctx, instance.GetClient(), ref.Namespace, ref.Name,
+		"", "", clusterproxy.GetClusterType(ref), logger)
+	if err != nil {
+		return nil, err
+	}
+	if restConfig == nil {
+		return nil, nil
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: instance.GetScheme()})
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterAccess{c: c, clientset: clientset}, nil
+}
+
+// collectCluster collects, from a cluster, all Sveltos resources, pods and events in the
+// Sveltos namespace, the logs of those pods and all resources of the kinds listed in extraResources.
+// Collection is best effort: errors are recorded in the returned entry.
+func collectCluster(ctx context.Context, access *clusterAccess, bundleFolder, folder string,
+	options *collectOptions, extraResources []schema.GroupVersionKind, logger logr.Logger) *clusterEntry {
+
+	entry := &clusterEntry{Folder: folder}
+	resourceFolder := filepath.Join(bundleFolder, folder, resourcesFolder)
+
+	gvks, err := getSveltosGVKs(ctx, access.c)
+	if err != nil {
+		entry.addError(fmt.Errorf("failed to list Sveltos CustomResourceDefinitions: %w", err))
+	}
+	gvks = append(gvks, extraResources...)
+	for i := range gvks {
+		if err := collectResources(ctx, access.c, gvks[i], "", resourceFolder, options, entry, logger); err != nil {
+			entry.addError(fmt.Errorf("failed to collect %s: %w", gvks[i].String(), err))
+		}
+	}
+
+	for _, kind := range []string{"Event", "Pod"} {
+		gvk := corev1.SchemeGroupVersion.WithKind(kind)
+		if err := collectResources(ctx, access.c, gvk, options.namespace, resourceFolder,
+			options, entry, logger); err != nil {
+			entry.addError(fmt.Errorf("failed to collect %s: %w", kind, err))
+		}
+	}
+
+	if access.clientset != nil {
+		collectLogs(ctx, access, filepath.Join(bundleFolder, folder, logsFolder), options, entry, logger)
+	}
+
+	return entry
+}
+
+// getSveltosGVKs returns the storage version of each CustomResourceDefinition in a Sveltos API group
+func getSveltosGVKs(ctx context.Context, c client.Client) ([]schema.GroupVersionKind, error) {
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := c.List(ctx, crds); err != nil {
+		return nil, err
+	}
+
+	gvks := make([]schema.GroupVersionKind, 0)
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if !strings.HasSuffix(crd.Spec.Group, sveltosGroupSuffix) {
+			continue
+		}
+		for j := range crd.Spec.Versions {
+			if crd.Spec.Versions[j].Storage {
+				gvks = append(gvks, schema.GroupVersionKind{
+					Group:   crd.Spec.Group,
+					Version: crd.Spec.Versions[j].Name,
+					Kind:    crd.Spec.Names.Kind,
+				})
+			}
+		}
+	}
+
+	return gvks, nil
+}
+
+// collectResources stores in folder, after redaction, all resources of the given kind.
+// If namespace is empty, resources in all namespaces are collected.
+func collectResources(ctx context.Context, c client.Client, gvk schema.GroupVersionKind,
+	namespace, folder string, options *collectOptions, entry *clusterEntry, logger logr.Logger) error {
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	listOptions := []client.ListOption{}
+	if namespace != "" {
+		listOptions = append(listOptions, client.InNamespace(namespace))
+	}
+
+	if err := c.List(ctx, list, listOptions...); err != nil {
+		return err
+	}
+
+	for i := range list.Items {
+		u := &list.Items[i]
+		u.SetGroupVersionKind(gvk)
+		options.redaction.redact(u)
+		if err := collector.DumpObject(u, folder, logger); err != nil {
+			return err
+		}
+		entry.Resources++
+	}
+
+	return nil
+}
+
+// collectLogs stores, in folder/<pod>/<container>.log, the logs of each container
+// of the pods running in the Sveltos namespace
+func collectLogs(ctx context.Context, access *clusterAccess, folder string, options *collectOptions,
+	entry *clusterEntry, logger logr.Logger) {
+
+	pods, err := access.clientset.CoreV1().Pods(options.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		entry.addError(fmt.Errorf("failed to list pods: %w", err))
+		return
+	}
+
+	sinceSeconds := int64(options.logsSince.Seconds())
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for j := range pod.Spec.Containers {
+			container := pod.Spec.Containers[j].Name
+			logOptions := &corev1.PodLogOptions{Container: container}
+			if sinceSeconds > 0 {
+				logOptions.SinceSeconds = &sinceSeconds
+			}
+
+			fileName := filepath.Join(folder, pod.Name, container+logFileExtension)
+			logger.V(logs.LogDebug).Info(fmt.Sprintf("storing logs of %s/%s container %s in %s",
+				pod.Namespace, pod.Name, container, fileName))
+			if err := storeLogs(ctx, access.clientset, pod, logOptions, fileName); err != nil {
+				entry.addError(fmt.Errorf("failed to collect logs of pod %s container %s: %w",
+					pod.Name, container, err))
+				continue
+			}
+			entry.Logs++
+		}
+	}
+}
+
+func storeLogs(ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod,
+	logOptions *corev1.PodLogOptions, fileName string) error {
+
+	stream, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := os.MkdirAll(filepath.Dir(fileName), dirPermission); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(fileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermission)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, stream)
+	return err
+}
+
+// parseResources parses a comma separated list of <apiVersion>/<Kind>, for instance
+// v1/ConfigMap,apps/v1/Deployment
+func parseResources(resources string) ([]schema.GroupVersionKind, error) {
+	gvks := make([]schema.GroupVersionKind, 0)
+	for _, resource := range strings.Split(resources, ",") {
+		resource = strings.TrimSpace(resource)
+		if resource == "" {
+			continue
+		}
+		index := strings.LastIndex(resource, "/")
+		if index <= 0 || index == len(resource)-1 {
+			return nil, fmt.Errorf("resource %q is not in the form <apiVersion>/<Kind>", resource)
+		}
+		gv, err := schema.ParseGroupVersion(resource[:index])
+		if err != nil {
+			return nil, fmt.Errorf("resource %q is not in the form <apiVersion>/<Kind>: %w", resource, err)
+		}
+		gvks = append(gvks, gv.WithKind(resource[index+1:]))
+	}
+	return gvks, nil
+}
+
+// Collect gathers data from management and managed clusters in a techsupport bundle
+func Collect(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl techsupport collect [options] [--output=<file>] [--namespace=<name>] [--since=<duration>]
+                                 [--redact=<policy>] [--clusters=<list>] [--managed-resources=<list>]
+                                 [--skip-managed-clusters] [--verbose]
+
+     --output=<file>             Name of the tar.gz file the bundle is stored in.
+                                 If not specified, sveltos-techsupport-<timestamp>.tar.gz is used.
+     --namespace=<name>          Namespace where Sveltos controllers run. Logs, pods and events are
+                                 collected from this namespace. If not specified, projectsveltos is used.
+     --since=<duration>          Only logs more recent than this duration (for instance 30m or 2h)
+                                 are collected. If not specified, 24h is used.
+     --redact=<policy>           Redaction applied to Secret data before being stored in the bundle.
+                                 Possible values are: all, none and keys:<key1>,<key2>.
+                                 If not specified, all is used.
+     --clusters=<list>           Comma separated list of managed clusters, in the form namespace/name,
+                                 to collect data from. If not specified all managed clusters are considered.
+     --managed-resources=<list>  Comma separated list of additional resources to collect from
+                                 managed clusters, in the form <apiVersion>/<Kind>
+                                 (for instance v1/ConfigMap,apps/v1/Deployment).
+     --skip-managed-clusters     Only collect data from the management cluster.
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The techsupport collect command gathers, in a single tar.gz file, the data needed to
+  troubleshoot Sveltos:
+  - from the management cluster, all Sveltos resources, and pods, events and logs of the
+    Sveltos controllers;
+  - from each managed cluster, accessed using the kubeconfig stored in the management cluster,
+    all Sveltos resources, pods, events and logs of the Sveltos agents and the resources
+    selected with --managed-resources.
+  Managed clusters in pull mode are not reachable from the management cluster and are skipped.
+  Collection is best effort: failures are reported in the bundle manifest.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	options, err := getCollectOptions(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	output := fmt.Sprintf("sveltos-techsupport-%s.tar.gz", time.Now().Format(bundleTimeFormat))
+	if passedOutput := parsedArgs["--output"]; passedOutput != nil {
+		output = passedOutput.(string)
+	}
+
+	return collect(ctx, options, output, logger)
+}
+
+func getCollectOptions(parsedArgs map[string]interface{}) (*collectOptions, error) {
+	options := &collectOptions{
+		namespace:            defaultNamespace,
+		logsSince:            defaultLogsSince,
+		redactionDescription: redactAll,
+		clusters:             make(map[string]bool),
+		skipManagedClusters:  parsedArgs["--skip-managed-clusters"].(bool),
+	}
+
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		options.namespace = passedNamespace.(string)
+	}
+
+	if passedSince := parsedArgs["--since"]; passedSince != nil {
+		since, err := time.ParseDuration(passedSince.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid since %q: %w", passedSince.(string), err)
+		}
+		options.logsSince = since
+	}
+
+	if passedRedact := parsedArgs["--redact"]; passedRedact != nil {
+		options.redactionDescription = passedRedact.(string)
+	}
+	redaction, err := parseRedactionPolicy(options.redactionDescription)
+	if err != nil {
+		return nil, err
+	}
+	options.redaction = redaction
+
+	if passedClusters := parsedArgs["--clusters"]; passedClusters != nil {
+		for _, cluster := range strings.Split(passedClusters.(string), ",") {
+			if cluster = strings.TrimSpace(cluster); cluster == "" {
+				continue
+			}
+			if strings.Count(cluster, "/") != 1 {
+				return nil, fmt.Errorf("cluster %q is not in the form namespace/name", cluster)
+			}
+			options.clusters[cluster] = true
+		}
+	}
+
+	if passedResources := parsedArgs["--managed-resources"]; passedResources != nil {
+		gvks, err := parseResources(passedResources.(string))
+		if err != nil {
+			return nil, err
+		}
+		options.managedResources = gvks
+	}
+
+	return options, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package techsupport
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	ParseRedactionPolicy = parseRedactionPolicy
+	ParseResources       = parseResources
+	CollectCluster       = collectCluster
+	CollectBundle        = collect
+
+	Redact = func(policy *redactionPolicy, u *unstructured.Unstructured) {
+		policy.redact(u)
+	}
+
+	NewClusterAccess = func(c client.Client, clientset kubernetes.Interface) *clusterAccess {
+		return &clusterAccess{c: c, clientset: clientset}
+	}
+
+	NewCollectOptions = func(namespace, redaction string, skipManagedClusters bool,
+		managedResources []schema.GroupVersionKind) (*collectOptions, error) {
+
+		policy, err := parseRedactionPolicy(redaction)
+		if err != nil {
+			return nil, err
+		}
+		return &collectOptions{
+			namespace:            namespace,
+			logsSince:            time.Hour,
+			redaction:            policy,
+			redactionDescription: redaction,
+			clusters:             map[string]bool{},
+			managedResources:     managedResources,
+			skipManagedClusters:  skipManagedClusters,
+		}, nil
+	}
+)
@@ -0,0 +1,94 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package techsupport
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	redactAll      = "all"
+	redactNone     = "none"
+	redactKeysPrfx = "keys:"
+
+	// redactedValue replaces redacted Secret data
+	redactedValue = "REDACTED"
+)
+
+// redactionPolicy defines which Secret data is redacted before being stored in the bundle
+type redactionPolicy struct {
+	// all, when set, causes every Secret data value to be redacted
+	all bool
+	// keys are the Secret data keys to redact when all is not set
+	keys map[string]bool
+}
+
+// parseRedactionPolicy parses a redaction policy: all, none or keys:<key1>,<key2>
+func parseRedactionPolicy(policy string) (*redactionPolicy, error) {
+	switch {
+	case policy == redactAll:
+		return &redactionPolicy{all: true}, nil
+	case policy == redactNone:
+		return &redactionPolicy{}, nil
+	case strings.HasPrefix(policy, redactKeysPrfx):
+		keys := make(map[string]bool)
+		for _, key := range strings.Split(strings.TrimPrefix(policy, redactKeysPrfx), ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				keys[key] = true
+			}
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("redact policy %q does not contain any key", policy)
+		}
+		return &redactionPolicy{keys: keys}, nil
+	default:
+		return nil, fmt.Errorf("possible values for redact are: %s, %s, %s<key1>,<key2>",
+			redactAll, redactNone, redactKeysPrfx)
+	}
+}
+
+// redact replaces, in Secrets, data and stringData values selected by the policy.
+// Keys are preserved, so it is still possible to tell which entries a Secret contains.
+// Objects of any other kind are left untouched.
+func (p *redactionPolicy) redact(u *unstructured.Unstructured) {
+	if u.GetKind() != "Secret" || u.GroupVersionKind().Group != "" {
+		return
+	}
+
+	for _, field := range []string{"data", "stringData"} {
+		values, found, err := unstructured.NestedMap(u.Object, field)
+		if err != nil || !found {
+			continue
+		}
+		for key := range values {
+			if p.all || p.keys[key] {
+				values[key] = redactedValue
+			}
+		}
+		_ = unstructured.SetNestedMap(u.Object, values, field)
+	}
+
+	// Last applied configuration would contain the original data
+	annotations := u.GetAnnotations()
+	if _, ok := annotations["kubectl.kubernetes.io/last-applied-configuration"]; ok && (p.all || len(p.keys) > 0) {
+		annotations["kubectl.kubernetes.io/last-applied-configuration"] = redactedValue
+		u.SetAnnotations(annotations)
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package techsupport_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/techsupport"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	namespace = "projectsveltos"
+)
+
+var _ = Describe("TechSupport", func() {
+	var clusterProfile *configv1beta1.ClusterProfile
+	var crd *apiextensionsv1.CustomResourceDefinition
+	var pod *corev1.Pod
+
+	BeforeEach(func() {
+		clusterProfile = &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: randomString()},
+		}
+
+		crd = &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "clusterprofiles.config.projectsveltos.io"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: configv1beta1.GroupVersion.Group,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{
+					Kind:   configv1beta1.ClusterProfileKind,
+					Plural: "clusterprofiles",
+				},
+				Scope: apiextensionsv1.ClusterScoped,
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: configv1beta1.GroupVersion.Version, Served: true, Storage: true},
+				},
+			},
+		}
+
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "addon-controller-" + randomString(),
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "controller"}},
+			},
+		}
+	})
+
+	It("parseRedactionPolicy validates the redaction policy", func() {
+		_, err := techsupport.ParseRedactionPolicy("all")
+		Expect(err).To(BeNil())
+		_, err = techsupport.ParseRedactionPolicy("none")
+		Expect(err).To(BeNil())
+		_, err = techsupport.ParseRedactionPolicy("keys:token,password")
+		Expect(err).To(BeNil())
+
+		_, err = techsupport.ParseRedactionPolicy("keys:")
+		Expect(err).ToNot(BeNil())
+		_, err = techsupport.ParseRedactionPolicy("some")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("redact replaces selected Secret data", func() {
+		secret := &unstructured.Unstructured{}
+		secret.SetAPIVersion("v1")
+		secret.SetKind("Secret")
+		Expect(unstructured.SetNestedStringMap(secret.Object,
+			map[string]string{"token": "dG9rZW4=", "ca.crt": "Y2E="}, "data")).To(Succeed())
+
+		policy, err := techsupport.ParseRedactionPolicy("keys:token")
+		Expect(err).To(BeNil())
+		redacted := secret.DeepCopy()
+		techsupport.Redact(policy, redacted)
+		data, _, err := unstructured.NestedStringMap(redacted.Object, "data")
+		Expect(err).To(BeNil())
+		Expect(data["token"]).To(Equal("REDACTED"))
+		Expect(data["ca.crt"]).To(Equal("Y2E="))
+
+		policy, err = techsupport.ParseRedactionPolicy("none")
+		Expect(err).To(BeNil())
+		redacted = secret.DeepCopy()
+		techsupport.Redact(policy, redacted)
+		Expect(redacted.Object).To(Equal(secret.Object))
+
+		policy, err = techsupport.ParseRedactionPolicy("all")
+		Expect(err).To(BeNil())
+		redacted = secret.DeepCopy()
+		techsupport.Redact(policy, redacted)
+		data, _, err = unstructured.NestedStringMap(redacted.Object, "data")
+		Expect(err).To(BeNil())
+		Expect(data).To(Equal(map[string]string{"token": "REDACTED", "ca.crt": "REDACTED"}))
+	})
+
+	It("parseResources parses apiVersion/Kind entries", func() {
+		gvks, err := techsupport.ParseResources("v1/ConfigMap, apps/v1/Deployment")
+		Expect(err).To(BeNil())
+		Expect(gvks).To(ConsistOf(
+			schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		))
+
+		_, err = techsupport.ParseResources("ConfigMap")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("collectCluster stores Sveltos resources, pods, logs and redacted Secrets", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: randomString(), Name: randomString()},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		}
+
+		c := initializeAccess(clusterProfile, crd, pod, secret)
+		clientset := fakeclientset.NewClientset(pod)
+
+		managedResources := []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Secret")}
+		options, err := techsupport.NewCollectOptions(namespace, "all", false, managedResources)
+		Expect(err).To(BeNil())
+
+		folder := GinkgoT().TempDir()
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		entry := techsupport.CollectCluster(context.TODO(), techsupport.NewClusterAccess(c, clientset),
+			folder, "managed", options, managedResources, logger)
+		Expect(entry.Errors).To(BeEmpty())
+		Expect(entry.Logs).To(Equal(1))
+
+		resources := filepath.Join(folder, "managed", "resources")
+		Expect(filepath.Join(resources, configv1beta1.ClusterProfileKind, clusterProfile.Name+".yaml")).To(BeAnExistingFile())
+		Expect(filepath.Join(resources, "Pod", namespace, pod.Name+".yaml")).To(BeAnExistingFile())
+		Expect(filepath.Join(folder, "managed", "logs", pod.Name, "controller.log")).To(BeAnExistingFile())
+
+		data, err := os.ReadFile(filepath.Join(resources, "Secret", secret.Namespace, secret.Name+".yaml"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring("password: REDACTED"))
+		Expect(string(data)).ToNot(ContainSubstring("c2VjcmV0"))
+	})
+
+	It("collect creates a tar.gz bundle with a manifest", func() {
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: randomString(), Name: randomString()},
+		}
+		initializeAccess(clusterProfile, crd, pod, sveltosCluster)
+
+		options, err := techsupport.NewCollectOptions(namespace, "all", false, nil)
+		Expect(err).To(BeNil())
+
+		output := filepath.Join(GinkgoT().TempDir(), "bundle.tar.gz")
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		Expect(techsupport.CollectBundle(context.TODO(), options, output, logger)).To(Succeed())
+
+		files := readBundle(output)
+		var manifest []byte
+		var clusterProfileFound bool
+		for name, content := range files {
+			switch {
+			case filepath.Base(name) == "manifest.yaml":
+				manifest = content
+			case filepath.Base(name) == clusterProfile.Name+".yaml":
+				clusterProfileFound = true
+			}
+		}
+		Expect(clusterProfileFound).To(BeTrue())
+		Expect(manifest).ToNot(BeNil())
+
+		parsed := map[string]interface{}{}
+		Expect(yaml.Unmarshal(manifest, &parsed)).To(Succeed())
+		Expect(parsed["namespace"]).To(Equal(namespace))
+		Expect(parsed["redaction"]).To(Equal("all"))
+		clusters := parsed["clusters"].([]interface{})
+		Expect(clusters).To(HaveLen(2))
+		Expect(clusters[0].(map[string]interface{})["cluster"]).To(Equal("management"))
+		// No kubeconfig is stored for the SveltosCluster: failure is reported in the manifest
+		managed := clusters[1].(map[string]interface{})
+		Expect(managed["cluster"]).To(ContainSubstring(sveltosCluster.Name))
+		Expect(managed["errors"]).ToNot(BeEmpty())
+	})
+})
+
+func initializeAccess(objects ...client.Object) client.Client {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	return c
+}
+
+// readBundle returns the content of each file in a tar.gz bundle
+func readBundle(fileName string) map[string][]byte {
+	f, err := os.Open(fileName)
+	Expect(err).To(BeNil())
+	defer f.Close()
+
+	gzipReader, err := gzip.NewReader(f)
+	Expect(err).To(BeNil())
+	tarReader := tar.NewReader(gzipReader)
+
+	files := make(map[string][]byte)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		Expect(err).To(BeNil())
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tarReader)
+		Expect(err).To(BeNil())
+		files[header.Name] = content
+	}
+	return files
+}
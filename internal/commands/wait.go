@@ -0,0 +1,32 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	"github.com/projectsveltos/sveltosctl/internal/commands/wait"
+)
+
+// Wait blocks till the add-ons of a ClusterProfile/Profile are deployed in all matching
+// clusters. It returns an error if deployment fails in any cluster or on timeout, so that
+// pipelines can gate on Sveltos rollouts.
+func Wait(ctx context.Context, args []string, logger logr.Logger) error {
+	return wait.Profile(ctx, args, logger)
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/docopt/docopt-go"
+	"github.com/go-logr/logr"
+	"github.com/olekukonko/tablewriter"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/libsveltos/lib/clusterproxy"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+const (
+	// pollInterval is how often deployment status is evaluated when no change is notified
+	pollInterval = 10 * time.Second
+
+	// statusPending is reported for features (or clusters) not processed yet
+	statusPending = "Pending"
+)
+
+// clusterDeployment is the deployment status of a profile in a matching cluster
+type clusterDeployment struct {
+	// cluster is the cluster => namespace/name
+	cluster string
+	// clusterSummary is the name of the ClusterSummary for this profile and cluster. It is
+	// empty if no ClusterSummary exists yet for this cluster
+	clusterSummary string
+	// outdated is true when the ClusterSummary is not deploying the current profile spec yet
+	outdated bool
+	// features is the status of each feature the profile deploys
+	features []featureDeployment
+}
+
+// featureDeployment is the deployment status of a feature in a cluster
+type featureDeployment struct {
+	featureID      libsveltosv1beta1.FeatureID
+	status         string
+	failureMessage string
+}
+
+// featureSnapshot is the status of a feature seen while its ClusterSummary was not deploying the
+// current profile spec yet. Till Hash, LastAppliedTime or ConsecutiveFailures change, the feature
+// status refers to the previous profile spec.
+type featureSnapshot struct {
+	hash                []byte
+	lastAppliedTime     *metav1.Time
+	consecutiveFailures uint
+}
+
+func newFeatureSnapshot(fs *configv1beta1.FeatureSummary) featureSnapshot {
+	if fs == nil {
+		return featureSnapshot{}
+	}
+	return featureSnapshot{hash: fs.Hash, lastAppliedTime: fs.LastAppliedTime,
+		consecutiveFailures: fs.ConsecutiveFailures}
+}
+
+func (s *featureSnapshot) isCurrent(fs *configv1beta1.FeatureSummary) bool {
+	current := newFeatureSnapshot(fs)
+	return string(s.hash) != string(current.hash) ||
+		!s.lastAppliedTime.Equal(current.lastAppliedTime) ||
+		s.consecutiveFailures != current.consecutiveFailures
+}
+
+func (d *clusterDeployment) isFailed() bool {
+	for i := range d.features {
+		if d.features[i].status == string(libsveltosv1beta1.FeatureStatusFailed) ||
+			d.features[i].status == string(libsveltosv1beta1.FeatureStatusFailedNonRetriable) {
+
+			return true
+		}
+	}
+	return false
+}
+
+// isProvisioned returns true when all features are provisioned. A ClusterSummary deploying
+// no feature is provisioned as soon as it deploys the current profile spec.
+func (d *clusterDeployment) isProvisioned() bool {
+	if d.clusterSummary == "" || d.outdated {
+		return false
+	}
+	for i := range d.features {
+		if d.features[i].status != string(libsveltosv1beta1.FeatureStatusProvisioned) {
+			return false
+		}
+	}
+	return true
+}
+
+// waitForProfile blocks till the profile add-ons are provisioned in all matching clusters.
+// It returns an error as soon as a feature fails in any cluster or when timeout expires.
+func waitForProfile(ctx context.Context, kind, namespace, name, passedClusterNamespace, passedCluster string,
+	timeout time.Duration, logger logr.Logger) error {
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instance := utils.GetAccessInstance()
+
+	events := make(chan struct{}, 1)
+	listOptions := []client.ListOption{}
+	if passedClusterNamespace != "" {
+		listOptions = append(listOptions, client.InNamespace(passedClusterNamespace))
+	}
+	watchErrors := make(chan error, 1)
+	go func() {
+		watchErrors <- instance.NotifyChanges(ctx, &configv1beta1.ClusterSummaryList{}, events, logger,
+			listOptions...)
+	}()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	profileName := fmt.Sprintf("%s/%s", kind, name)
+	if namespace != "" {
+		profileName = fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+	}
+
+	// Feature statuses left over by a previous profile spec, by ClusterSummary and feature
+	staleFeatures := make(map[string]featureSnapshot)
+
+	var pending []clusterDeployment
+	for {
+		deployments, err := collectDeployments(ctx, kind, namespace, name,
+			passedClusterNamespace, passedCluster, staleFeatures, logger)
+		if err != nil {
+			if ctx.Err() == nil {
+				return err
+			}
+		} else {
+			failed := make([]clusterDeployment, 0)
+			pending = make([]clusterDeployment, 0)
+			for i := range deployments {
+				switch {
+				case deployments[i].isFailed():
+					failed = append(failed, deployments[i])
+				case !deployments[i].isProvisioned():
+					pending = append(pending, deployments[i])
+				}
+			}
+
+			if len(failed) > 0 {
+				if err := printDeployments(failed); err != nil {
+					return err
+				}
+				return fmt.Errorf("%s failed to deploy in %d cluster(s)", profileName, len(failed))
+			}
+
+			switch {
+			case len(deployments) == 0:
+				// Profile status might not have been reconciled yet
+				logger.V(logs.LogDebug).Info(fmt.Sprintf("%s is not matching any cluster yet", profileName))
+			case len(pending) == 0:
+				logger.V(logs.LogInfo).Info(fmt.Sprintf("%s is provisioned in %d cluster(s)",
+					profileName, len(deployments)))
+				return nil
+			default:
+				logger.V(logs.LogDebug).Info(fmt.Sprintf("%d cluster(s) still pending", len(pending)))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if len(pending) == 0 {
+				return fmt.Errorf("timed out after %s waiting for %s to match at least one cluster",
+					timeout, profileName)
+			}
+			if err := printDeployments(pending); err != nil {
+				return err
+			}
+			return fmt.Errorf("timed out after %s waiting for %s in %d cluster(s)",
+				timeout, profileName, len(pending))
+		case err := <-watchErrors:
+			if err != nil {
+				return err
+			}
+		case <-events:
+		case <-ticker.C:
+		}
+	}
+}
+
+// collectDeployments returns the deployment status of the profile in each matching cluster.
+// When a cluster is passed but it is not matching the profile (yet), it is reported as pending.
+// staleFeatures is updated with the feature statuses which refer to a previous profile spec.
+func collectDeployments(ctx context.Context, kind, namespace, name, passedClusterNamespace, passedCluster string,
+	staleFeatures map[string]featureSnapshot, logger logr.Logger) ([]clusterDeployment, error) {
+
+	instance := utils.GetAccessInstance()
+
+	profile, err := instance.GetProfileInstance(ctx, kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterSummaries, err := instance.ListClusterSummaries(ctx, passedClusterNamespace, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := make([]clusterDeployment, 0)
+	for i := range profile.Status.MatchingClusterRefs {
+		ref := &profile.Status.MatchingClusterRefs[i]
+		if passedClusterNamespace != "" && ref.Namespace != passedClusterNamespace {
+			continue
+		}
+		if passedCluster != "" && ref.Name != passedCluster {
+			continue
+		}
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("Considering cluster %s:%s/%s", ref.Kind, ref.Namespace, ref.Name))
+		deployments = append(deployments, getClusterDeployment(profile, ref, clusterSummaries, staleFeatures))
+	}
+
+	if passedCluster != "" && len(deployments) == 0 {
+		cluster := passedCluster
+		if passedClusterNamespace != "" {
+			cluster = fmt.Sprintf("%s/%s", passedClusterNamespace, passedCluster)
+		}
+		deployments = append(deployments, clusterDeployment{cluster: cluster})
+	}
+
+	return deployments, nil
+}
+
+// getClusterDeployment returns the deployment status of the profile in a cluster. Features are
+// reported as pending till the ClusterSummary deploys the current profile spec and their
+// status is updated after that.
+func getClusterDeployment(profile *utils.ProfileInstance, ref *corev1.ObjectReference,
+	clusterSummaries *configv1beta1.ClusterSummaryList, staleFeatures map[string]featureSnapshot,
+) clusterDeployment {
+
+	result := clusterDeployment{
+		cluster: fmt.Sprintf("%s/%s", ref.Namespace, ref.Name),
+	}
+
+	clusterType := clusterproxy.GetClusterType(ref)
+	for i := range clusterSummaries.Items {
+		cs := &clusterSummaries.Items[i]
+		if cs.Spec.ClusterNamespace != ref.Namespace || cs.Spec.ClusterName != ref.Name ||
+			cs.Spec.ClusterType != clusterType {
+
+			continue
+		}
+		owner, err := configv1beta1.GetProfileOwnerReference(cs)
+		if err != nil || owner == nil || owner.Kind != profile.Kind || owner.Name != profile.Name {
+			continue
+		}
+
+		result.clusterSummary = cs.Name
+		result.outdated = !utils.IsClusterSummaryUpToDate(cs, profile.Spec)
+		for _, featureID := range utils.GetExpectedFeatures(profile.Spec) {
+			key := fmt.Sprintf("%s/%s/%s", cs.Namespace, cs.Name, featureID)
+			fs := getFeatureSummary(cs, featureID)
+			if result.outdated {
+				staleFeatures[key] = newFeatureSnapshot(fs)
+				result.features = append(result.features, featureDeployment{featureID: featureID, status: statusPending})
+				continue
+			}
+			if snapshot, ok := staleFeatures[key]; ok {
+				if !snapshot.isCurrent(fs) {
+					result.features = append(result.features, featureDeployment{featureID: featureID, status: statusPending})
+					continue
+				}
+				delete(staleFeatures, key)
+			}
+			result.features = append(result.features, getFeatureDeployment(fs, featureID))
+		}
+		break
+	}
+
+	return result
+}
+
+// getFeatureSummary returns the status of a feature in the ClusterSummary, nil if not reported yet
+func getFeatureSummary(cs *configv1beta1.ClusterSummary, featureID libsveltosv1beta1.FeatureID,
+) *configv1beta1.FeatureSummary {
+
+	for i := range cs.Status.FeatureSummaries {
+		if cs.Status.FeatureSummaries[i].FeatureID == featureID {
+			return &cs.Status.FeatureSummaries[i]
+		}
+	}
+	return nil
+}
+
+func getFeatureDeployment(fs *configv1beta1.FeatureSummary, featureID libsveltosv1beta1.FeatureID,
+) featureDeployment {
+
+	if fs == nil {
+		return featureDeployment{featureID: featureID, status: statusPending}
+	}
+
+	result := featureDeployment{featureID: featureID, status: string(fs.Status)}
+	if result.status == "" {
+		result.status = statusPending
+	}
+	if fs.FailureMessage != nil {
+		result.failureMessage = *fs.FailureMessage
+	}
+	return result
+}
+
+func printDeployments(deployments []clusterDeployment) error {
+	if len(deployments) == 0 {
+		return nil
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.Header([]string{"CLUSTER", "FEATURE", "STATUS", "FAILURE MESSAGE"})
+	for i := range deployments {
+		if deployments[i].clusterSummary == "" || (deployments[i].outdated && len(deployments[i].features) == 0) {
+			if err := table.Append([]string{deployments[i].cluster, "", statusPending, ""}); err != nil {
+				return err
+			}
+			continue
+		}
+		for j := range deployments[i].features {
+			feature := &deployments[i].features[j]
+			if feature.status == string(libsveltosv1beta1.FeatureStatusProvisioned) {
+				continue
+			}
+			row := []string{deployments[i].cluster, string(feature.featureID), feature.status, feature.failureMessage}
+			if err := table.Append(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	return table.Render()
+}
+
+// Profile waits for a ClusterProfile/Profile add-ons to be deployed in all matching clusters
+func Profile(ctx context.Context, args []string, logger logr.Logger) error {
+	doc := `Usage:
+  sveltosctl wait [options] --profile=<kind/name> [--namespace=<name>] [--cluster=<name>]
+                  [--timeout=<duration>] [--verbose]
+
+     --profile=<kind/name>  ClusterProfile/<name> or Profile/<namespace>/<name> to wait for.
+     --namespace=<name>     Wait only for clusters in this namespace.
+                            If not specified all namespaces are considered.
+     --cluster=<name>       Wait only for cluster with name.
+                            If not specified all matching clusters are considered.
+     --timeout=<duration>   Maximum time to wait, for instance 90s or 10m [default: 5m].
+
+Options:
+  -h --help                  Show this screen.
+     --verbose               Verbose mode. Print each step.
+
+Description:
+  The wait command watches ClusterSummary feature statuses for all clusters matching the
+  ClusterProfile/Profile. It exits successfully when all features are Provisioned.
+  Feature statuses are only considered once the ClusterSummary deploys the current profile spec.
+  Statuses seen while it did not (left over by a previous deployment) are ignored till the
+  feature Hash, LastAppliedTime or ConsecutiveFailures change.
+  A ClusterProfile/Profile not matching any cluster yet (for instance, not reconciled yet) is
+  considered pending till timeout expires.
+  It fails as soon as a feature is Failed or FailedNonRetriable in any cluster, or when
+  timeout expires, printing the clusters not provisioned and their failure message.
+`
+	parsedArgs, err := docopt.ParseArgs(doc, nil, "1.0")
+	if err != nil {
+		logger.V(logs.LogInfo).Error(err, "failed to parse args")
+		return fmt.Errorf(
+			"invalid option: 'sveltosctl %s'. Use flag '--help' to read about a specific subcommand. Error: %w",
+			strings.Join(args, " "),
+			err,
+		)
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	_ = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogInfo))
+	verbose := parsedArgs["--verbose"].(bool)
+	if verbose {
+		err = flag.Lookup("v").Value.Set(fmt.Sprint(logs.LogDebug))
+		if err != nil {
+			return err
+		}
+	}
+
+	kind, namespace, name, err := utils.ParseProfileReference(parsedArgs["--profile"].(string))
+	if err != nil {
+		return err
+	}
+
+	clusterNamespace := ""
+	if passedNamespace := parsedArgs["--namespace"]; passedNamespace != nil {
+		clusterNamespace = passedNamespace.(string)
+	}
+
+	cluster := ""
+	if passedCluster := parsedArgs["--cluster"]; passedCluster != nil {
+		cluster = passedCluster.(string)
+	}
+
+	timeout, err := time.ParseDuration(parsedArgs["--timeout"].(string))
+	if err != nil {
+		return fmt.Errorf("invalid timeout: %w", err)
+	}
+
+	return waitForProfile(ctx, kind, namespace, name, clusterNamespace, cluster, timeout, logger)
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/commands/wait"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Wait", func() {
+	var clusterProfile *configv1beta1.ClusterProfile
+	var clusterSummary *configv1beta1.ClusterSummary
+	var old *os.File
+
+	BeforeEach(func() {
+		clusterNamespace := randomString()
+		clusterName := randomString()
+
+		clusterProfile = &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+			Status: configv1beta1.Status{
+				MatchingClusterRefs: []corev1.ObjectReference{
+					{
+						Kind:       libsveltosv1beta1.SveltosClusterKind,
+						APIVersion: libsveltosv1beta1.GroupVersion.String(),
+						Namespace:  clusterNamespace,
+						Name:       clusterName,
+					},
+				},
+			},
+		}
+
+		clusterSummary = &configv1beta1.ClusterSummary{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: clusterNamespace,
+				Name:      randomString(),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: configv1beta1.GroupVersion.String(),
+						Kind:       configv1beta1.ClusterProfileKind,
+						Name:       clusterProfile.Name,
+						UID:        "123",
+					},
+				},
+			},
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterNamespace: clusterNamespace,
+				ClusterName:      clusterName,
+				ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+				ClusterProfileSpec: configv1beta1.Spec{
+					HelmCharts: []configv1beta1.HelmChart{
+						{
+							RepositoryURL:    "https://kyverno.github.io/kyverno/",
+							RepositoryName:   "kyverno",
+							ChartName:        "kyverno/kyverno",
+							ChartVersion:     "v3.2.5",
+							ReleaseName:      "kyverno-latest",
+							ReleaseNamespace: "kyverno",
+						},
+					},
+				},
+			},
+		}
+
+		clusterProfile.Spec = *clusterSummary.Spec.ClusterProfileSpec.DeepCopy()
+
+		// Tables with failing clusters are printed on stdout
+		old = os.Stdout
+		os.Stdout, _ = os.Open(os.DevNull)
+	})
+
+	AfterEach(func() {
+		os.Stdout = old
+	})
+
+	It("waitForProfile returns when all features are provisioned", func() {
+		clusterSummary.Status.FeatureSummaries = []configv1beta1.FeatureSummary{
+			{FeatureID: libsveltosv1beta1.FeatureHelm, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+		}
+		initializeAccess(clusterProfile, clusterSummary)
+
+		Expect(wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Minute, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))).To(Succeed())
+	})
+
+	It("waitForProfile returns an error when a feature fails", func() {
+		failureMessage := randomString()
+		clusterSummary.Status.FeatureSummaries = []configv1beta1.FeatureSummary{
+			{
+				FeatureID:      libsveltosv1beta1.FeatureHelm,
+				Status:         libsveltosv1beta1.FeatureStatusFailedNonRetriable,
+				FailureMessage: &failureMessage,
+			},
+		}
+		initializeAccess(clusterProfile, clusterSummary)
+
+		err := wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Minute, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed to deploy in 1 cluster(s)"))
+	})
+
+	It("waitForProfile returns an error on timeout", func() {
+		initializeAccess(clusterProfile, clusterSummary)
+
+		err := wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Second, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("timed out"))
+	})
+
+	It("waitForProfile returns an error on timeout when cluster is not matching", func() {
+		clusterSummary.Status.FeatureSummaries = []configv1beta1.FeatureSummary{
+			{FeatureID: libsveltosv1beta1.FeatureHelm, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+		}
+		initializeAccess(clusterProfile, clusterSummary)
+
+		err := wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			randomString(), randomString(), time.Second,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("timed out"))
+	})
+
+	It("waitForProfile waits for a profile not matching any cluster yet", func() {
+		clusterProfile.Status.MatchingClusterRefs = nil
+		initializeAccess(clusterProfile, clusterSummary)
+
+		err := wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Second, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("to match at least one cluster"))
+	})
+
+	It("waitForProfile considers a ClusterSummary deploying no feature provisioned", func() {
+		clusterSummary.Spec.ClusterProfileSpec.HelmCharts = nil
+		clusterProfile.Spec.HelmCharts = nil
+		initializeAccess(clusterProfile, clusterSummary)
+
+		Expect(wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Minute, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))).To(Succeed())
+	})
+
+	It("waitForProfile does not consider a ClusterSummary deploying a previous profile spec provisioned", func() {
+		clusterSummary.Status.FeatureSummaries = []configv1beta1.FeatureSummary{
+			{FeatureID: libsveltosv1beta1.FeatureHelm, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+		}
+		clusterProfile.Spec.HelmCharts[0].ChartVersion = "v3.2.6"
+		initializeAccess(clusterProfile, clusterSummary)
+
+		err := wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Second, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("timed out"))
+	})
+
+	It("waitForProfile ignores feature statuses left over by a previous profile spec", func() {
+		clusterSummary.Status.FeatureSummaries = []configv1beta1.FeatureSummary{
+			{FeatureID: libsveltosv1beta1.FeatureHelm, Status: libsveltosv1beta1.FeatureStatusProvisioned,
+				Hash: []byte("previous")},
+		}
+		clusterProfile.Spec.HelmCharts[0].ChartVersion = "v3.2.6"
+		c := initializeAccess(clusterProfile, clusterSummary)
+
+		updated := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			// Profile spec is propagated to the ClusterSummary, status is not updated yet
+			time.Sleep(time.Second)
+			current := &configv1beta1.ClusterSummary{}
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(clusterSummary), current)).To(Succeed())
+			current.Spec.ClusterProfileSpec = clusterProfile.Spec
+			Expect(c.Update(context.TODO(), current)).To(Succeed())
+
+			// Profile spec is deployed
+			time.Sleep(time.Second)
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(clusterSummary), current)).To(Succeed())
+			current.Status.FeatureSummaries[0].Hash = []byte("current")
+			close(updated)
+			Expect(c.Status().Update(context.TODO(), current)).To(Succeed())
+		}()
+
+		Expect(wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Minute, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))).To(Succeed())
+		Expect(updated).To(BeClosed())
+	})
+
+	It("waitForProfile prints only the cluster name when namespace is not passed", func() {
+		r, w, err := os.Pipe()
+		Expect(err).To(BeNil())
+		os.Stdout = w
+
+		clusterName := randomString()
+		initializeAccess(clusterProfile, clusterSummary)
+		err = wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", clusterName, time.Second, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		w.Close()
+
+		output, err := io.ReadAll(r)
+		Expect(err).To(BeNil())
+		Expect(string(output)).To(ContainSubstring(" " + clusterName + " "))
+		Expect(string(output)).ToNot(ContainSubstring("/" + clusterName))
+	})
+
+	It("waitForProfile detects ClusterSummary changes", func() {
+		c := initializeAccess(clusterProfile, clusterSummary)
+
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(time.Second)
+			current := &configv1beta1.ClusterSummary{}
+			Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(clusterSummary), current)).To(Succeed())
+			current.Status.FeatureSummaries = []configv1beta1.FeatureSummary{
+				{FeatureID: libsveltosv1beta1.FeatureHelm, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+			}
+			Expect(c.Status().Update(context.TODO(), current)).To(Succeed())
+		}()
+
+		Expect(wait.WaitForProfile(context.TODO(), configv1beta1.ClusterProfileKind, "", clusterProfile.Name,
+			"", "", time.Minute, textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))).To(Succeed())
+	})
+})
+
+func initializeAccess(objects ...client.Object) client.Client {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+		WithStatusSubresource(&configv1beta1.ClusterSummary{}).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	return c
+}
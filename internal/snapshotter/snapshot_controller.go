@@ -0,0 +1,134 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	utilsv1beta1 "github.com/projectsveltos/sveltosctl/api/v1beta1"
+)
+
+// SnapshotReconciler reconciles a Snapshot object. On each scheduled time, it stores
+// the Sveltos configuration in a new sample.
+type SnapshotReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func (r *SnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	logger.V(logs.LogDebug).Info("Reconciling")
+
+	snapshot := &utilsv1beta1.Snapshot{}
+	if err := r.Get(ctx, req.NamespacedName, snapshot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !snapshot.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	missedRun, nextRun, err := getNextScheduleTime(snapshot, now)
+	if err != nil {
+		// Schedule cannot be fixed by retrying. Snapshot will be reconciled again on update.
+		logger.V(logs.LogInfo).Info(fmt.Sprintf("invalid schedule: %v", err))
+		failed := utilsv1beta1.CollectionStatusFailed
+		snapshot.Status.LastRunStatus = &failed
+		failureMessage := err.Error()
+		snapshot.Status.FailureMessage = &failureMessage
+		snapshot.Status.NextScheduleTime = nil
+		return ctrl.Result{}, r.Status().Update(ctx, snapshot)
+	}
+
+	if !missedRun.IsZero() {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("run scheduled at %s", missedRun.Format(time.RFC3339)))
+		snapshot.Status.LastRunTime = &metav1.Time{Time: now}
+		if err := collectSample(ctx, snapshot, now, logger); err != nil {
+			logger.V(logs.LogInfo).Info(fmt.Sprintf("failed to collect sample: %v", err))
+			failed := utilsv1beta1.CollectionStatusFailed
+			snapshot.Status.LastRunStatus = &failed
+			failureMessage := err.Error()
+			snapshot.Status.FailureMessage = &failureMessage
+		} else {
+			collected := utilsv1beta1.CollectionStatusCollected
+			snapshot.Status.LastRunStatus = &collected
+			snapshot.Status.FailureMessage = nil
+			if err := cleanOldSamples(snapshot, logger); err != nil {
+				logger.V(logs.LogInfo).Info(fmt.Sprintf("failed to remove old samples: %v", err))
+			}
+		}
+	}
+
+	snapshot.Status.NextScheduleTime = &metav1.Time{Time: nextRun}
+	if err := r.Status().Update(ctx, snapshot); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: nextRun.Sub(now)}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Status updates are ignored: samples are collected on RequeueAfter.
+func (r *SnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&utilsv1beta1.Snapshot{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// getNextScheduleTime returns the most recent scheduled time that was missed (zero if none)
+// and the next time a sample has to be collected.
+// If StartingDeadlineSeconds is set, scheduled times older than the deadline are not
+// considered missed.
+func getNextScheduleTime(snapshot *utilsv1beta1.Snapshot, now time.Time) (missedRun, nextRun time.Time, err error) {
+	sched, err := cron.ParseStandard(snapshot.Spec.Schedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("unparseable schedule %q: %w", snapshot.Spec.Schedule, err)
+	}
+
+	earliestTime := snapshot.CreationTimestamp.Time
+	if snapshot.Status.LastRunTime != nil {
+		earliestTime = snapshot.Status.LastRunTime.Time
+	}
+	if snapshot.Spec.StartingDeadlineSeconds != nil {
+		schedulingDeadline := now.Add(-time.Duration(*snapshot.Spec.StartingDeadlineSeconds) * time.Second)
+		if schedulingDeadline.After(earliestTime) {
+			earliestTime = schedulingDeadline
+		}
+	}
+
+	for t := sched.Next(earliestTime); !t.After(now); t = sched.Next(t) {
+		missedRun = t
+	}
+
+	return missedRun, sched.Next(now), nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	utilsv1beta1 "github.com/projectsveltos/sveltosctl/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/snapshotter"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Snapshot controller", func() {
+	var snapshot *utilsv1beta1.Snapshot
+
+	BeforeEach(func() {
+		snapshot = &utilsv1beta1.Snapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              randomString(),
+				CreationTimestamp: metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+			},
+			Spec: utilsv1beta1.SnapshotSpec{
+				Schedule: "0 * * * *",
+				Storage:  GinkgoT().TempDir(),
+			},
+		}
+	})
+
+	It("getNextScheduleTime returns last missed run and next run", func() {
+		now := time.Date(2026, 1, 10, 10, 30, 0, 0, time.UTC)
+		snapshot.CreationTimestamp = metav1.Time{Time: now.Add(-2 * time.Hour)}
+
+		missedRun, nextRun, err := snapshotter.GetNextScheduleTime(snapshot, now)
+		Expect(err).To(BeNil())
+		Expect(missedRun).To(Equal(time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC)))
+		Expect(nextRun).To(Equal(time.Date(2026, 1, 10, 11, 0, 0, 0, time.UTC)))
+
+		// Last run after most recent scheduled time: nothing missed
+		snapshot.Status.LastRunTime = &metav1.Time{Time: now.Add(-10 * time.Minute)}
+		missedRun, _, err = snapshotter.GetNextScheduleTime(snapshot, now)
+		Expect(err).To(BeNil())
+		Expect(missedRun.IsZero()).To(BeTrue())
+
+		// Missed run older than starting deadline is not considered
+		snapshot.Status.LastRunTime = nil
+		deadline := int64(60)
+		snapshot.Spec.StartingDeadlineSeconds = &deadline
+		missedRun, _, err = snapshotter.GetNextScheduleTime(snapshot, now)
+		Expect(err).To(BeNil())
+		Expect(missedRun.IsZero()).To(BeTrue())
+
+		snapshot.Spec.Schedule = randomString()
+		_, _, err = snapshotter.GetNextScheduleTime(snapshot, now)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Reconcile collects a sample and removes old ones", func() {
+		limit := int32(2)
+		snapshot.Spec.SuccessfulSnapshotLimit = &limit
+
+		// Existing old samples
+		oldSamples := []string{"2025-01-01:10:00:00", "2025-01-01:11:00:00"}
+		for i := range oldSamples {
+			Expect(os.MkdirAll(filepath.Join(snapshot.Spec.Storage, snapshot.Name, oldSamples[i]), 0o755)).To(Succeed())
+		}
+
+		clusterProfile := &configv1beta1.ClusterProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: randomString(),
+			},
+		}
+
+		c := initializeAccess(snapshot, clusterProfile)
+
+		reconciler := &snapshotter.SnapshotReconciler{Client: c, Scheme: c.Scheme()}
+		result, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: snapshot.Name}})
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).ToNot(BeZero())
+
+		current := &utilsv1beta1.Snapshot{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: snapshot.Name}, current)).To(Succeed())
+		Expect(current.Status.LastRunStatus).ToNot(BeNil())
+		Expect(*current.Status.LastRunStatus).To(Equal(utilsv1beta1.CollectionStatusCollected))
+		Expect(current.Status.NextScheduleTime).ToNot(BeNil())
+
+		samples, err := snapshotter.ListSamples(current)
+		Expect(err).To(BeNil())
+		Expect(samples).To(HaveLen(int(limit)))
+		Expect(samples[0]).To(Equal(oldSamples[1]))
+
+		folder, err := snapshotter.GetSampleFolder(current, samples[1])
+		Expect(err).To(BeNil())
+		_, err = os.Stat(filepath.Join(folder, configv1beta1.ClusterProfileKind, clusterProfile.Name+".yaml"))
+		Expect(err).To(BeNil())
+	})
+
+	It("Reconcile reports failure when storage does not exist", func() {
+		snapshot.Spec.Storage = filepath.Join(snapshot.Spec.Storage, randomString())
+
+		c := initializeAccess(snapshot)
+
+		reconciler := &snapshotter.SnapshotReconciler{Client: c, Scheme: c.Scheme()}
+		_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: snapshot.Name}})
+		Expect(err).To(BeNil())
+
+		current := &utilsv1beta1.Snapshot{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: snapshot.Name}, current)).To(Succeed())
+		Expect(current.Status.LastRunStatus).ToNot(BeNil())
+		Expect(*current.Status.LastRunStatus).To(Equal(utilsv1beta1.CollectionStatusFailed))
+		Expect(current.Status.FailureMessage).ToNot(BeNil())
+	})
+})
+
+func initializeAccess(objects ...client.Object) client.Client {
+	scheme, err := utils.GetScheme()
+	Expect(err).To(BeNil())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).
+		WithStatusSubresource(&utilsv1beta1.Snapshot{}).Build()
+	utils.InitalizeManagementClusterAcces(scheme, nil, nil, c)
+	return c
+}
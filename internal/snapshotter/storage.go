@@ -0,0 +1,123 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	utilsv1beta1 "github.com/projectsveltos/sveltosctl/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/collector"
+)
+
+const (
+	// SampleTimeFormat is the format of the name of each collected sample
+	SampleTimeFormat = "2006-01-02:15:04:05"
+)
+
+// GetSnapshotFolder returns the folder containing all samples collected for a Snapshot
+func GetSnapshotFolder(snapshot *utilsv1beta1.Snapshot) string {
+	return filepath.Join(snapshot.Spec.Storage, snapshot.Name)
+}
+
+// ListSamples returns the name of all samples collected for a Snapshot, oldest first
+func ListSamples(snapshot *utilsv1beta1.Snapshot) ([]string, error) {
+	entries, err := os.ReadDir(GetSnapshotFolder(snapshot))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	samples := make([]string, 0, len(entries))
+	for i := range entries {
+		if !entries[i].IsDir() {
+			continue
+		}
+		if _, err := time.Parse(SampleTimeFormat, entries[i].Name()); err != nil {
+			continue
+		}
+		samples = append(samples, entries[i].Name())
+	}
+
+	// Sample names are timestamps, so lexicographical order is chronological order
+	sort.Strings(samples)
+	return samples, nil
+}
+
+// GetSampleFolder returns the folder containing a sample collected for a Snapshot
+func GetSampleFolder(snapshot *utilsv1beta1.Snapshot, sample string) (string, error) {
+	folder := filepath.Join(GetSnapshotFolder(snapshot), sample)
+	info, err := os.Stat(folder)
+	if err != nil || !info.IsDir() {
+		return "", fmt.Errorf("sample %s not found for snapshot %s", sample, snapshot.Name)
+	}
+	return folder, nil
+}
+
+// collectSample stores the current Sveltos configuration in a new sample named after now
+func collectSample(ctx context.Context, snapshot *utilsv1beta1.Snapshot, now time.Time,
+	logger logr.Logger) error {
+
+	if info, err := os.Stat(snapshot.Spec.Storage); err != nil || !info.IsDir() {
+		return fmt.Errorf("storage %s is not an existing directory", snapshot.Spec.Storage)
+	}
+
+	sample := now.Format(SampleTimeFormat)
+	logger.V(logs.LogInfo).Info(fmt.Sprintf("collecting sample %s", sample))
+
+	folder := filepath.Join(GetSnapshotFolder(snapshot), sample)
+	if err := collector.CollectSveltosConfiguration(ctx, folder, logger); err != nil {
+		// Do not leave a partial sample behind
+		_ = os.RemoveAll(folder)
+		return err
+	}
+
+	return nil
+}
+
+// cleanOldSamples removes the oldest samples when more than SuccessfulSnapshotLimit exist
+func cleanOldSamples(snapshot *utilsv1beta1.Snapshot, logger logr.Logger) error {
+	if snapshot.Spec.SuccessfulSnapshotLimit == nil {
+		return nil
+	}
+
+	samples, err := ListSamples(snapshot)
+	if err != nil {
+		return err
+	}
+
+	limit := int(*snapshot.Spec.SuccessfulSnapshotLimit)
+	for i := 0; i < len(samples)-limit; i++ {
+		logger.V(logs.LogDebug).Info(fmt.Sprintf("removing sample %s", samples[i]))
+		if err := os.RemoveAll(filepath.Join(GetSnapshotFolder(snapshot), samples[i])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+)
+
+// ParseClusterNamespaceName parses a cluster passed in the form namespace/name
+func ParseClusterNamespaceName(cluster string) (namespace, name string, err error) {
+	info := strings.Split(cluster, "/")
+	if len(info) != 2 || info[0] == "" || info[1] == "" {
+		return "", "", fmt.Errorf("cluster must be in the form namespace/name")
+	}
+
+	return info[0], info[1], nil
+}
+
+// ParseClusterType parses a cluster type. Accepted values are sveltos and capi (case insensitive).
+func ParseClusterType(clusterType string) (libsveltosv1beta1.ClusterType, error) {
+	switch {
+	case strings.EqualFold(clusterType, string(libsveltosv1beta1.ClusterTypeSveltos)):
+		return libsveltosv1beta1.ClusterTypeSveltos, nil
+	case strings.EqualFold(clusterType, string(libsveltosv1beta1.ClusterTypeCapi)):
+		return libsveltosv1beta1.ClusterTypeCapi, nil
+	default:
+		return "", fmt.Errorf("possible values for cluster-type are: sveltos, capi")
+	}
+}
+
+// ListManagedClusters returns all SveltosClusters and CAPI Clusters. If namespace is not empty,
+// only clusters in that namespace are returned. Kind and APIVersion are set on each returned cluster.
+func (a *k8sAccess) ListManagedClusters(ctx context.Context, namespace string) ([]client.Object, error) {
+	listOptions := []client.ListOption{}
+	if namespace != "" {
+		listOptions = append(listOptions, client.InNamespace(namespace))
+	}
+
+	clusters := make([]client.Object, 0)
+
+	sveltosClusters := &libsveltosv1beta1.SveltosClusterList{}
+	if err := a.ListResources(ctx, sveltosClusters, listOptions...); err != nil {
+		return nil, err
+	}
+	for i := range sveltosClusters.Items {
+		sveltosClusters.Items[i].Kind = libsveltosv1beta1.SveltosClusterKind
+		sveltosClusters.Items[i].APIVersion = libsveltosv1beta1.GroupVersion.String()
+		clusters = append(clusters, &sveltosClusters.Items[i])
+	}
+
+	capiClusters := &clusterv1.ClusterList{}
+	if err := a.ListResources(ctx, capiClusters, listOptions...); err != nil {
+		// CAPI might not be installed in the management cluster
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return clusters, nil
+		}
+		return nil, err
+	}
+	for i := range capiClusters.Items {
+		capiClusters.Items[i].Kind = clusterv1.ClusterKind
+		capiClusters.Items[i].APIVersion = clusterv1.GroupVersion.String()
+		clusters = append(clusters, &capiClusters.Items[i])
+	}
+
+	return clusters, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Clusters", func() {
+	It("ParseClusterNamespaceName parses namespace/name", func() {
+		namespace, name, err := utils.ParseClusterNamespaceName("default/prod")
+		Expect(err).To(BeNil())
+		Expect(namespace).To(Equal("default"))
+		Expect(name).To(Equal("prod"))
+
+		_, _, err = utils.ParseClusterNamespaceName("prod")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("ParseClusterType accepts sveltos and capi", func() {
+		clusterType, err := utils.ParseClusterType("sveltos")
+		Expect(err).To(BeNil())
+		Expect(clusterType).To(Equal(libsveltosv1beta1.ClusterTypeSveltos))
+
+		clusterType, err = utils.ParseClusterType("Capi")
+		Expect(err).To(BeNil())
+		Expect(clusterType).To(Equal(libsveltosv1beta1.ClusterTypeCapi))
+
+		_, err = utils.ParseClusterType("kind")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("ListManagedClusters returns SveltosClusters and CAPI Clusters", func() {
+		namespace := randomString()
+		sveltosCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: randomString()},
+		}
+		capiCluster := &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: randomString()},
+		}
+		otherCluster := &libsveltosv1beta1.SveltosCluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: randomString(), Name: randomString()},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(utils.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sveltosCluster, capiCluster, otherCluster).Build()
+
+		k8sAccess := utils.GetK8sAccess(scheme, c)
+		clusters, err := k8sAccess.ListManagedClusters(context.TODO(), namespace)
+		Expect(err).To(BeNil())
+		Expect(clusters).To(HaveLen(2))
+		for i := range clusters {
+			kind := clusters[i].GetObjectKind().GroupVersionKind().Kind
+			Expect(kind).To(BeElementOf(libsveltosv1beta1.SveltosClusterKind, clusterv1.ClusterKind))
+		}
+
+		clusters, err = k8sAccess.ListManagedClusters(context.TODO(), "")
+		Expect(err).To(BeNil())
+		Expect(clusters).To(HaveLen(3))
+	})
+})
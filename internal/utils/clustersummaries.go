@@ -0,0 +1,93 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+)
+
+// ListClusterSummaries returns all current ClusterSummaries. If namespace is not empty,
+// only ClusterSummaries in that namespace are returned
+func (a *k8sAccess) ListClusterSummaries(ctx context.Context, namespace string,
+	logger logr.Logger) (*configv1beta1.ClusterSummaryList, error) {
+
+	logger.V(logs.LogDebug).Info("Get all ClusterSummaries")
+
+	listOptions := []client.ListOption{}
+	if namespace != "" {
+		listOptions = []client.ListOption{
+			client.InNamespace(namespace),
+		}
+	}
+
+	clusterSummaries := &configv1beta1.ClusterSummaryList{}
+	err := a.client.List(ctx, clusterSummaries, listOptions...)
+	return clusterSummaries, err
+}
+
+// GetExpectedFeatures returns the features a ClusterSummary deploying the profile spec is
+// expected to report on
+func GetExpectedFeatures(spec *configv1beta1.Spec) []libsveltosv1beta1.FeatureID {
+	features := make([]libsveltosv1beta1.FeatureID, 0)
+	if len(spec.HelmCharts) > 0 {
+		features = append(features, libsveltosv1beta1.FeatureHelm)
+	}
+	if len(spec.PolicyRefs) > 0 {
+		features = append(features, libsveltosv1beta1.FeatureResources)
+	}
+	if len(spec.KustomizationRefs) > 0 {
+		features = append(features, libsveltosv1beta1.FeatureKustomize)
+	}
+	return features
+}
+
+// IsClusterSummaryUpToDate returns true if the ClusterSummary deploys the current profile spec.
+// Till it does, its feature statuses refer to a previous profile spec.
+func IsClusterSummaryUpToDate(cs *configv1beta1.ClusterSummary, spec *configv1beta1.Spec) bool {
+	return equality.Semantic.DeepEqual(&cs.Spec.ClusterProfileSpec, spec)
+}
+
+// IsClusterSummaryProvisioned returns true if the ClusterSummary deploys the current profile spec
+// and every feature the profile deploys is provisioned
+func IsClusterSummaryProvisioned(cs *configv1beta1.ClusterSummary, spec *configv1beta1.Spec) bool {
+	if !IsClusterSummaryUpToDate(cs, spec) {
+		return false
+	}
+
+	for _, featureID := range GetExpectedFeatures(spec) {
+		provisioned := false
+		for i := range cs.Status.FeatureSummaries {
+			fs := &cs.Status.FeatureSummaries[i]
+			if fs.FeatureID == featureID && fs.Status == libsveltosv1beta1.FeatureStatusProvisioned {
+				provisioned = true
+				break
+			}
+		}
+		if !provisioned {
+			return false
+		}
+	}
+	return true
+}
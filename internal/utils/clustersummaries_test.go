@@ -0,0 +1,101 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("ClusterSummaries", func() {
+	It("ListClusterSummaries returns list of all ClusterSummaries in a given namespace", func() {
+		initObjects := []client.Object{}
+
+		for i := 0; i < 10; i++ {
+			cs := &configv1beta1.ClusterSummary{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      randomString(),
+					Namespace: randomString(),
+				},
+				Spec: configv1beta1.ClusterSummarySpec{
+					ClusterNamespace: randomString(),
+					ClusterName:      randomString(),
+					ClusterType:      libsveltosv1beta1.ClusterTypeSveltos,
+				},
+			}
+			initObjects = append(initObjects, cs)
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(utils.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+
+		k8sAccess := utils.GetK8sAccess(scheme, c)
+		clusterSummaries, err := k8sAccess.ListClusterSummaries(context.TODO(), "",
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+		Expect(len(clusterSummaries.Items)).To(Equal(len(initObjects)))
+
+		clusterSummaries, err = k8sAccess.ListClusterSummaries(context.TODO(), initObjects[0].GetNamespace(),
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).To(BeNil())
+		Expect(len(clusterSummaries.Items)).To(Equal(1))
+	})
+
+	It("IsClusterSummaryProvisioned requires the current profile spec and all features provisioned", func() {
+		spec := &configv1beta1.Spec{
+			HelmCharts: []configv1beta1.HelmChart{{ReleaseName: randomString(), ReleaseNamespace: randomString()}},
+			PolicyRefs: []configv1beta1.PolicyRef{{Namespace: randomString(), Name: randomString()}},
+		}
+		Expect(utils.GetExpectedFeatures(spec)).To(ConsistOf(libsveltosv1beta1.FeatureHelm,
+			libsveltosv1beta1.FeatureResources))
+
+		cs := &configv1beta1.ClusterSummary{
+			Spec: configv1beta1.ClusterSummarySpec{
+				ClusterProfileSpec: *spec.DeepCopy(),
+			},
+			Status: configv1beta1.ClusterSummaryStatus{
+				FeatureSummaries: []configv1beta1.FeatureSummary{
+					{FeatureID: libsveltosv1beta1.FeatureHelm, Status: libsveltosv1beta1.FeatureStatusProvisioned},
+					{FeatureID: libsveltosv1beta1.FeatureResources, Status: libsveltosv1beta1.FeatureStatusProvisioning},
+				},
+			},
+		}
+		Expect(utils.IsClusterSummaryUpToDate(cs, spec)).To(BeTrue())
+		Expect(utils.IsClusterSummaryProvisioned(cs, spec)).To(BeFalse())
+
+		cs.Status.FeatureSummaries[1].Status = libsveltosv1beta1.FeatureStatusProvisioned
+		Expect(utils.IsClusterSummaryProvisioned(cs, spec)).To(BeTrue())
+
+		// Statuses refer to a previous profile spec
+		spec.Tier = 10
+		Expect(utils.IsClusterSummaryUpToDate(cs, spec)).To(BeFalse())
+		Expect(utils.IsClusterSummaryProvisioned(cs, spec)).To(BeFalse())
+	})
+})
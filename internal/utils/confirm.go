@@ -0,0 +1,113 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// DryRunMode is the value of the --dry-run option of commands modifying objects
+type DryRunMode string
+
+const (
+	// DryRunNone means changes are applied
+	DryRunNone = DryRunMode("none")
+
+	// DryRunClient means changes are only printed
+	DryRunClient = DryRunMode("client")
+
+	// DryRunServer means changes are submitted to the API server, which validates
+	// them without persisting them
+	DryRunServer = DryRunMode("server")
+)
+
+// ErrAborted is returned when the user does not confirm the changes
+var ErrAborted = errors.New("aborted: no changes made")
+
+// Change describes a change a command is about to make to an object
+type Change struct {
+	// Action is the operation performed on the object (for instance patch, update or delete)
+	Action string
+	// Object identifies the object (for instance ClusterSummary/namespace/name)
+	Object string
+	// Details describes the change
+	Details string
+}
+
+// ParseDryRun validates the value of the --dry-run option. A nil value means changes are applied.
+func ParseDryRun(value interface{}) (DryRunMode, error) {
+	if value == nil {
+		return DryRunNone, nil
+	}
+
+	switch mode := DryRunMode(value.(string)); mode {
+	case DryRunNone, DryRunClient, DryRunServer:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid dry-run %q: accepted values are %q, %q and %q",
+			value, DryRunNone, DryRunClient, DryRunServer)
+	}
+}
+
+// ConfirmChanges prints the changes a command is about to make.
+// With DryRunClient nothing else happens and false is returned.
+// With DryRunServer true is returned, changes must be submitted in dry-run mode.
+// Otherwise, unless yes is set, user is asked to confirm on in. ErrAborted is returned
+// if the changes are not confirmed.
+func ConfirmChanges(in io.Reader, out io.Writer, changes []Change, dryRun DryRunMode, yes bool) (bool, error) {
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "No changes to apply.")
+		return false, nil
+	}
+
+	table := tablewriter.NewWriter(out)
+	table.Header([]string{"ACTION", "OBJECT", "DETAILS"})
+	for i := range changes {
+		if err := table.Append([]string{changes[i].Action, changes[i].Object, changes[i].Details}); err != nil {
+			return false, err
+		}
+	}
+	if err := table.Render(); err != nil {
+		return false, err
+	}
+
+	switch {
+	case dryRun == DryRunClient:
+		fmt.Fprintln(out, "Dry run (client): no changes made.")
+		return false, nil
+	case dryRun == DryRunServer, yes:
+		return true, nil
+	}
+
+	fmt.Fprint(out, "Do you want to apply these changes? [y/N]: ")
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, ErrAborted
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Confirm", func() {
+	changes := []utils.Change{
+		{Action: "delete", Object: "Secret/default/kubeconfig"},
+	}
+
+	It("ParseDryRun validates the dry-run mode", func() {
+		mode, err := utils.ParseDryRun(nil)
+		Expect(err).To(BeNil())
+		Expect(mode).To(Equal(utils.DryRunNone))
+
+		mode, err = utils.ParseDryRun("server")
+		Expect(err).To(BeNil())
+		Expect(mode).To(Equal(utils.DryRunServer))
+
+		_, err = utils.ParseDryRun("all")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("ConfirmChanges prints changes and asks for confirmation", func() {
+		var out bytes.Buffer
+		apply, err := utils.ConfirmChanges(strings.NewReader("y\n"), &out, changes, utils.DryRunNone, false)
+		Expect(err).To(BeNil())
+		Expect(apply).To(BeTrue())
+		Expect(out.String()).To(ContainSubstring("Secret/default/kubeconfig"))
+
+		apply, err = utils.ConfirmChanges(strings.NewReader(""), &out, changes, utils.DryRunNone, false)
+		Expect(err).To(MatchError(utils.ErrAborted))
+		Expect(apply).To(BeFalse())
+
+		// --yes and server dry-run do not ask for confirmation
+		apply, err = utils.ConfirmChanges(nil, &out, changes, utils.DryRunNone, true)
+		Expect(err).To(BeNil())
+		Expect(apply).To(BeTrue())
+		apply, err = utils.ConfirmChanges(nil, &out, changes, utils.DryRunServer, false)
+		Expect(err).To(BeNil())
+		Expect(apply).To(BeTrue())
+
+		// client dry-run only prints changes
+		apply, err = utils.ConfirmChanges(nil, &out, changes, utils.DryRunClient, false)
+		Expect(err).To(BeNil())
+		Expect(apply).To(BeFalse())
+	})
+})
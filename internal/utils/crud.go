@@ -18,6 +18,8 @@ package utils
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -26,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 
 	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
@@ -36,6 +39,16 @@ import (
 const (
 	// retryableErrorTimeout is the time process sleep before a retryble error is hit
 	retryableErrorTimeout = time.Second
+
+	// watchRestartDelay is the time waited before restarting a watch closed by the server
+	watchRestartDelay = time.Second
+
+	// maxWatchRestartDelay is the maximum time waited before retrying a watch which failed
+	maxWatchRestartDelay = 30 * time.Second
+)
+
+var (
+	errWatchNotSupported = errors.New("client does not support watch")
 )
 
 // ListResources retrieves list of objects for a given namespace and list options.
@@ -59,6 +72,83 @@ func (a *k8sAccess) ListResources(ctx context.Context, list client.ObjectList, o
 	return nil
 }
 
+// WatchResources starts a watch on objects of the given list type and list options.
+func (a *k8sAccess) WatchResources(ctx context.Context, list client.ObjectList,
+	opts ...client.ListOption) (watch.Interface, error) {
+
+	c, ok := a.client.(client.WithWatch)
+	if !ok {
+		return nil, errWatchNotSupported
+	}
+	return c.Watch(ctx, list, opts...)
+}
+
+// NotifyChanges watches objects of the given list type and signals every change on events.
+// Notifications are coalesced: a pending notification is not duplicated. Watch is restarted
+// whenever it is closed by the API server, till context is cancelled.
+// Watch failures are retried with exponential backoff, except the ones which cannot succeed
+// on retry (for instance Forbidden or NotFound) which are returned.
+func (a *k8sAccess) NotifyChanges(ctx context.Context, list client.ObjectList, events chan<- struct{},
+	logger logr.Logger, opts ...client.ListOption) error {
+
+	backoff := watchRestartDelay
+	for {
+		delay := watchRestartDelay
+		w, err := a.WatchResources(ctx, list.DeepCopyObject().(client.ObjectList), opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if isPermanentWatchError(err) {
+				return fmt.Errorf("failed to watch %T: %w", list, err)
+			}
+			delay = backoff
+			backoff = min(2*backoff, maxWatchRestartDelay)
+			logger.V(logs.LogInfo).Info(fmt.Sprintf("failed to watch %T: %v. Retrying in %s", list, err, delay))
+		} else {
+			backoff = watchRestartDelay
+			consumeWatchEvents(ctx, w, events)
+			w.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isPermanentWatchError returns true for watch errors which cannot succeed on retry
+func isPermanentWatchError(err error) bool {
+	return errors.Is(err, errWatchNotSupported) ||
+		apierrors.IsForbidden(err) ||
+		apierrors.IsUnauthorized(err) ||
+		apierrors.IsNotFound(err) ||
+		apierrors.IsMethodNotSupported(err)
+}
+
+// consumeWatchEvents signals events till either the watch is closed or context is cancelled
+func consumeWatchEvents(ctx context.Context, w watch.Interface, events chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+			if event.Type == watch.Bookmark || event.Type == watch.Error {
+				continue
+			}
+			select {
+			case events <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
 // UpdateResource creates or updates a resource in a CAPI Cluster.
 func (a *k8sAccess) UpdateResourceWithDynamicResourceInterface(ctx context.Context, dr dynamic.ResourceInterface,
 	object *unstructured.Unstructured, logger logr.Logger) error {
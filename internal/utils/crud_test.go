@@ -29,12 +29,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2/textlogger"
 	kubectlscheme "k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 
 	"github.com/projectsveltos/sveltosctl/internal/utils"
@@ -226,3 +229,29 @@ var _ = Describe("CRUD", func() {
 		Expect(len(currentNs.Labels)).To(Equal(currentLabelLength + 1))
 	})
 })
+
+var _ = Describe("NotifyChanges", func() {
+	It("NotifyChanges returns watch errors which cannot succeed on retry", func() {
+		scheme := runtime.NewScheme()
+		Expect(utils.AddToScheme(scheme)).To(Succeed())
+
+		watches := 0
+		c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Watch: func(_ context.Context, _ client.WithWatch, _ client.ObjectList,
+				_ ...client.ListOption) (watch.Interface, error) {
+
+				watches++
+				return nil, apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "",
+					fmt.Errorf("forbidden"))
+			},
+		}).Build()
+
+		k8sAccess := utils.GetK8sAccess(scheme, c)
+		events := make(chan struct{}, 1)
+		err := k8sAccess.NotifyChanges(context.TODO(), &corev1.NamespaceList{}, events,
+			textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1))))
+		Expect(err).ToNot(BeNil())
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		Expect(watches).To(Equal(1))
+	})
+})
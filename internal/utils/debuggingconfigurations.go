@@ -48,10 +48,11 @@ func (a *k8sAccess) GetDebuggingConfiguration(
 }
 
 // UpdateDebuggingConfiguration creates, if not existing already, default DebuggingConfiguration. Otherwise
-// updates it.
+// updates it. A dry-run option in opts applies to the creation as well.
 func (a *k8sAccess) UpdateDebuggingConfiguration(
 	ctx context.Context,
 	dc *libsveltosv1beta1.DebuggingConfiguration,
+	opts ...client.UpdateOption,
 ) error {
 
 	reqName := client.ObjectKey{
@@ -63,16 +64,18 @@ func (a *k8sAccess) UpdateDebuggingConfiguration(
 	err := a.client.Get(ctx, reqName, tmp)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			err = a.client.Create(ctx, dc)
-			if err != nil {
-				return err
+			updateOptions := &client.UpdateOptions{}
+			updateOptions.ApplyOptions(opts)
+			createOptions := []client.CreateOption{}
+			if len(updateOptions.DryRun) != 0 {
+				createOptions = append(createOptions, client.DryRunAll)
 			}
-		} else {
-			return err
+			return a.client.Create(ctx, dc, createOptions...)
 		}
+		return err
 	}
 
-	err = a.client.Update(ctx, dc)
+	err = a.client.Update(ctx, dc, opts...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libsveltosv1beta1 "github.com/projectsveltos/libsveltos/api/v1beta1"
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+)
+
+// ListEventReports returns all current EventReports. If namespace is not empty,
+// only EventReports in that namespace are returned
+func (a *k8sAccess) ListEventReports(ctx context.Context, namespace string,
+	logger logr.Logger) (*libsveltosv1beta1.EventReportList, error) {
+
+	logger.V(logs.LogDebug).Info("Get all EventReports")
+
+	listOptions := []client.ListOption{}
+	if namespace != "" {
+		listOptions = []client.ListOption{
+			client.InNamespace(namespace),
+		}
+	}
+
+	eventReports := &libsveltosv1beta1.EventReportList{}
+	err := a.client.List(ctx, eventReports, listOptions...)
+	return eventReports, err
+}
@@ -18,8 +18,14 @@ package utils
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	configv1beta1 "github.com/projectsveltos/addon-controller/api/v1beta1"
 	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
@@ -34,3 +40,69 @@ func (a *k8sAccess) ListProfiles(ctx context.Context,
 	err := a.client.List(ctx, profiles)
 	return profiles, err
 }
+
+// ProfileInstance contains the fields common to ClusterProfile and Profile
+type ProfileInstance struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Spec      *configv1beta1.Spec
+	Status    *configv1beta1.Status
+	// Object is the ClusterProfile/Profile instance
+	Object client.Object
+}
+
+// ParseProfileReference parses ClusterProfile/<name> or Profile/<namespace>/<name>
+func ParseProfileReference(profile string) (kind, namespace, name string, err error) {
+	info := strings.Split(profile, "/")
+	switch {
+	case len(info) == 2 && info[0] == configv1beta1.ClusterProfileKind && info[1] != "":
+		return info[0], "", info[1], nil
+	case len(info) == 3 && info[0] == configv1beta1.ProfileKind && info[1] != "" && info[2] != "":
+		return info[0], info[1], info[2], nil
+	default:
+		return "", "", "", fmt.Errorf("profile must be in the form %s/<name> or %s/<namespace>/<name>",
+			configv1beta1.ClusterProfileKind, configv1beta1.ProfileKind)
+	}
+}
+
+// GetProfileInstance fetches the ClusterProfile/Profile. Namespace is ignored for ClusterProfiles.
+func (a *k8sAccess) GetProfileInstance(ctx context.Context, kind, namespace, name string,
+) (*ProfileInstance, error) {
+
+	switch kind {
+	case configv1beta1.ClusterProfileKind:
+		clusterProfile := &configv1beta1.ClusterProfile{}
+		if err := a.GetResource(ctx, types.NamespacedName{Name: name}, clusterProfile); err != nil {
+			return nil, err
+		}
+		return &ProfileInstance{Kind: kind, Name: name,
+			Spec: &clusterProfile.Spec, Status: &clusterProfile.Status, Object: clusterProfile}, nil
+	case configv1beta1.ProfileKind:
+		profile := &configv1beta1.Profile{}
+		if err := a.GetResource(ctx, types.NamespacedName{Namespace: namespace, Name: name}, profile); err != nil {
+			return nil, err
+		}
+		return &ProfileInstance{Kind: kind, Namespace: namespace, Name: name,
+			Spec: &profile.Spec, Status: &profile.Status, Object: profile}, nil
+	default:
+		return nil, fmt.Errorf("kind must be %s or %s", configv1beta1.ClusterProfileKind, configv1beta1.ProfileKind)
+	}
+}
+
+// IsProfilePaused returns true if the ClusterProfile/Profile has the paused annotation
+func IsProfilePaused(profile metav1.Object) bool {
+	_, ok := profile.GetAnnotations()[configv1beta1.ProfilePausedAnnotation]
+	return ok
+}
+
+// GetMatchingClusters returns the clusters, as namespace/name, in a ClusterProfile/Profile
+// Status.MatchingClusterRefs
+func GetMatchingClusters(matchingClusterRefs []corev1.ObjectReference) []string {
+	clusters := make([]string, len(matchingClusterRefs))
+	for i := range matchingClusterRefs {
+		c := &matchingClusterRefs[i]
+		clusters[i] = fmt.Sprintf("%s/%s", c.Namespace, c.Name)
+	}
+	return clusters
+}
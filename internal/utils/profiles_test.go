@@ -64,4 +64,23 @@ var _ = Describe("Profile", func() {
 		Expect(err).To(BeNil())
 		Expect(len(profiles.Items)).To(Equal(len(initObjects)))
 	})
+
+	It("ParseProfileReference parses ClusterProfile and Profile references", func() {
+		kind, namespace, name, err := utils.ParseProfileReference("ClusterProfile/deploy-kyverno")
+		Expect(err).To(BeNil())
+		Expect(kind).To(Equal(configv1beta1.ClusterProfileKind))
+		Expect(namespace).To(BeEmpty())
+		Expect(name).To(Equal("deploy-kyverno"))
+
+		kind, namespace, name, err = utils.ParseProfileReference("Profile/eng/deploy-kyverno")
+		Expect(err).To(BeNil())
+		Expect(kind).To(Equal(configv1beta1.ProfileKind))
+		Expect(namespace).To(Equal("eng"))
+		Expect(name).To(Equal("deploy-kyverno"))
+
+		_, _, _, err = utils.ParseProfileReference("Profile/deploy-kyverno")
+		Expect(err).ToNot(BeNil())
+		_, _, _, err = utils.ParseProfileReference("ConfigMap/deploy-kyverno")
+		Expect(err).ToNot(BeNil())
+	})
 })
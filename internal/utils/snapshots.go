@@ -0,0 +1,47 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+
+	logs "github.com/projectsveltos/libsveltos/lib/logsettings"
+	utilsv1beta1 "github.com/projectsveltos/sveltosctl/api/v1beta1"
+)
+
+// ListSnapshots returns all current Snapshots
+func (a *k8sAccess) ListSnapshots(ctx context.Context,
+	logger logr.Logger) (*utilsv1beta1.SnapshotList, error) {
+
+	logger.V(logs.LogDebug).Info("Get all Snapshots")
+	snapshots := &utilsv1beta1.SnapshotList{}
+	err := a.client.List(ctx, snapshots)
+	return snapshots, err
+}
+
+// GetSnapshot returns the Snapshot with the given name
+func (a *k8sAccess) GetSnapshot(ctx context.Context, name string,
+	logger logr.Logger) (*utilsv1beta1.Snapshot, error) {
+
+	logger.V(logs.LogDebug).Info("Get Snapshot " + name)
+	snapshot := &utilsv1beta1.Snapshot{}
+	err := a.client.Get(ctx, types.NamespacedName{Name: name}, snapshot)
+	return snapshot, err
+}
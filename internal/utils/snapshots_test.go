@@ -0,0 +1,66 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2/textlogger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	utilsv1beta1 "github.com/projectsveltos/sveltosctl/api/v1beta1"
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Snapshot", func() {
+	It("ListSnapshots returns list of all snapshots and GetSnapshot a single one", func() {
+		initObjects := []client.Object{}
+
+		for i := 0; i < 10; i++ {
+			snapshot := &utilsv1beta1.Snapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: randomString(),
+				},
+				Spec: utilsv1beta1.SnapshotSpec{
+					Schedule: "0 * * * *",
+					Storage:  "/tmp/" + randomString(),
+				},
+			}
+			initObjects = append(initObjects, snapshot)
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(utils.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjects...).Build()
+
+		k8sAccess := utils.GetK8sAccess(scheme, c)
+		logger := textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(1)))
+		snapshots, err := k8sAccess.ListSnapshots(context.TODO(), logger)
+		Expect(err).To(BeNil())
+		Expect(len(snapshots.Items)).To(Equal(len(initObjects)))
+
+		snapshot, err := k8sAccess.GetSnapshot(context.TODO(), initObjects[0].GetName(), logger)
+		Expect(err).To(BeNil())
+		Expect(snapshot.Spec.Storage).To(Equal(initObjects[0].(*utilsv1beta1.Snapshot).Spec.Storage))
+	})
+})
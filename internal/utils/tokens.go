@@ -0,0 +1,87 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	serviceAccountSubjectPrefix = "system:serviceaccount:"
+	jwtParts                    = 3
+)
+
+// TokenClaims contains the claims of a ServiceAccount token
+type TokenClaims struct {
+	Expiration int64  `json:"exp,omitempty"`
+	IssuedAt   int64  `json:"iat,omitempty"`
+	Subject    string `json:"sub,omitempty"`
+}
+
+// ParseTokenClaims decodes, without verifying it, the payload of a ServiceAccount token.
+// Tokens which are not JWTs (or do not expire) return nil claims.
+func ParseTokenClaims(token string) (*TokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != jwtParts {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token: %w", err)
+	}
+
+	claims := &TokenClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("failed to decode token: %w", err)
+	}
+	if claims.Expiration == 0 {
+		return nil, nil
+	}
+
+	return claims, nil
+}
+
+// GetServiceAccount returns namespace and name of the ServiceAccount a token was issued for
+func (c *TokenClaims) GetServiceAccount() (namespace, name string, err error) {
+	const namespaceAndName = 2
+	info := strings.Split(strings.TrimPrefix(c.Subject, serviceAccountSubjectPrefix), ":")
+	if !strings.HasPrefix(c.Subject, serviceAccountSubjectPrefix) || len(info) != namespaceAndName {
+		return "", "", fmt.Errorf("token subject %q is not a ServiceAccount", c.Subject)
+	}
+	return info[0], info[1], nil
+}
+
+// GetExpiration returns when the token expires
+func (c *TokenClaims) GetExpiration() time.Time {
+	return time.Unix(c.Expiration, 0)
+}
+
+// GetTokenExpiration returns when a ServiceAccount token expires. Nil is returned for
+// tokens which do not expire.
+func GetTokenExpiration(token string) (*time.Time, error) {
+	claims, err := ParseTokenClaims(token)
+	if err != nil || claims == nil {
+		return nil, err
+	}
+	expiration := claims.GetExpiration()
+	return &expiration, nil
+}
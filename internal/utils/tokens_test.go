@@ -0,0 +1,66 @@
+/*
+Copyright 2026. projectsveltos.io. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectsveltos/sveltosctl/internal/utils"
+)
+
+var _ = Describe("Tokens", func() {
+	It("ParseTokenClaims returns nil for non expiring tokens", func() {
+		claims, err := utils.ParseTokenClaims(randomString())
+		Expect(err).To(BeNil())
+		Expect(claims).To(BeNil())
+
+		claims, err = utils.ParseTokenClaims(generateToken(time.Now().Add(time.Hour)))
+		Expect(err).To(BeNil())
+		Expect(claims).ToNot(BeNil())
+
+		namespace, name, err := claims.GetServiceAccount()
+		Expect(err).To(BeNil())
+		Expect(namespace).To(Equal("projectsveltos"))
+		Expect(name).To(Equal("sveltos"))
+	})
+
+	It("GetTokenExpiration returns when token expires", func() {
+		expiration, err := utils.GetTokenExpiration(randomString())
+		Expect(err).To(BeNil())
+		Expect(expiration).To(BeNil())
+
+		expected := time.Now().Add(time.Hour).Truncate(time.Second)
+		expiration, err = utils.GetTokenExpiration(generateToken(expected))
+		Expect(err).To(BeNil())
+		Expect(expiration).ToNot(BeNil())
+		Expect(expiration.Equal(expected)).To(BeTrue())
+	})
+})
+
+// generateToken returns an unsigned JWT with the claims of a ServiceAccount token
+func generateToken(expiration time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(
+		`{"iat":%d,"exp":%d,"sub":"system:serviceaccount:projectsveltos:sveltos"}`,
+		time.Now().Unix(), expiration.Unix())))
+	return fmt.Sprintf("%s.%s.signature", header, payload)
+}